@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"github.com/spf13/cobra"
+	"hub/cmd/apikey/create"
+	"hub/cmd/apikey/list"
+	"hub/cmd/apikey/revoke"
+)
+
+func NewAPIKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "API key management commands",
+		Long:  `Manage API keys for machine clients - create, list and revoke keys`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(create.NewCommand())
+	cmd.AddCommand(list.NewCommand())
+	cmd.AddCommand(revoke.NewCommand())
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appapikey "hub/internal/application/apikey"
+	"hub/internal/wire"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCommand() *cobra.Command {
+	var (
+		name        string
+		scopes      []string
+		stationSlug string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API key",
+		Long:  `Create an API key with the given scopes, optionally restricted to one station. The secret is printed once and cannot be recovered.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, cleanup, err := wire.InitializeAPIKeyApp()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			defer app.Database.Pool().Close()
+
+			result, err := app.Create.Handle(context.Background(), appapikey.CreateAPIKeyCommand{
+				Name:    name,
+				Scopes:  scopes,
+				Station: stationSlug,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("ID:     %s\n", result.Key.ID)
+			fmt.Printf("Name:   %s\n", result.Key.Name)
+			fmt.Printf("Scopes: %s\n", strings.Join(result.Key.Scopes, ", "))
+			if stationSlug != "" {
+				fmt.Printf("Station: %s\n", stationSlug)
+			}
+			fmt.Printf("Secret: %s\n", result.Secret)
+			fmt.Println("Store the secret now, it will not be shown again.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Human-readable key name, e.g. playout")
+	cmd.Flags().StringSliceVarP(&scopes, "scope", "s", []string{"tracks:write"}, "Granted scopes: tracks:write, admin")
+	cmd.Flags().StringVar(&stationSlug, "station", "", "Slug of the only station the key grants; every station when empty")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
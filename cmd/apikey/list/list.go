@@ -0,0 +1,61 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"hub/internal/wire"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List API keys",
+		Long:  `List every API key with its scopes, station, last use and revocation time.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, cleanup, err := wire.InitializeAPIKeyApp()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			defer app.Database.Pool().Close()
+
+			keys, err := app.List.Handle(context.Background())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tPREFIX\tSCOPES\tSTATION\tCREATED\tLAST USED\tREVOKED")
+			for _, k := range keys {
+				station := "*"
+				if k.StationID != 0 {
+					station = fmt.Sprint(k.StationID)
+					if s, err := app.Stations.ByID(k.StationID); err == nil {
+						station = s.Slug()
+					}
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					k.ID, k.Name, k.Prefix, strings.Join(k.Scopes, ","), station,
+					k.CreatedAt.Format(time.RFC3339), formatTime(k.LastUsedAt), formatTime(k.RevokedAt),
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	return cmd
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,37 @@
+package revoke
+
+import (
+	"context"
+	"fmt"
+
+	appapikey "hub/internal/application/apikey"
+	"hub/internal/wire"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke an API key",
+		Long:  `Revoke an API key by ID. Requests made with a revoked key are rejected immediately.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, cleanup, err := wire.InitializeAPIKeyApp()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			defer app.Database.Pool().Close()
+
+			if err := app.Revoke.Handle(context.Background(), appapikey.RevokeAPIKeyCommand{ID: args[0]}); err != nil {
+				return err
+			}
+
+			fmt.Printf("Revoked API key %s\n", args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -5,8 +5,10 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"hub/cmd/apikey"
 	"hub/cmd/migrate"
 	"hub/cmd/serve"
+	"hub/cmd/tracks"
 )
 
 var rootCmd = &cobra.Command{
@@ -28,6 +30,8 @@ func init() {
 	// Add subcommands
 	rootCmd.AddCommand(serve.NewServeCommand())
 	rootCmd.AddCommand(migrate.NewMigrateCommand())
+	rootCmd.AddCommand(tracks.NewTracksCommand())
+	rootCmd.AddCommand(apikey.NewAPIKeyCommand())
 }
 
 func exitWithError(err error) {
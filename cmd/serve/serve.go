@@ -7,6 +7,7 @@ import (
 	"syscall"
 	"time"
 
+	"hub/internal/config"
 	"hub/internal/wire"
 
 	"github.com/spf13/cobra"
@@ -39,6 +40,20 @@ func NewServeCommand() *cobra.Command {
 				return app.Server.Listen(app.Config.Port())
 			})
 
+			if port := app.Config.MetricsPort(); port != 0 {
+				g.Go(func() error {
+					return app.AdminServer.Listen(port)
+				})
+			}
+
+			eventBus, _, _, _ := app.Config.EventBus()
+			if eventBus == config.EventBusRedis {
+				app.EventBus.Start()
+			}
+			app.OutboxRelay.Start()
+			app.Webhooks.Start()
+			app.Live.Start()
+
 			if app.Config.SchedulerEnabled() {
 				g.Go(func() error {
 					app.Scheduler.Start()
@@ -64,6 +79,27 @@ func NewServeCommand() *cobra.Command {
 					}
 				}
 
+				relayCtx, relayCancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer relayCancel()
+				if err := app.OutboxRelay.Stop(relayCtx); err != nil {
+					app.Logger.Errorf("outbox relay stop error: %v", err)
+				}
+				if err := app.Webhooks.Stop(relayCtx); err != nil {
+					app.Logger.Errorf("webhook worker stop error: %v", err)
+				}
+				if err := app.EventBus.Stop(relayCtx); err != nil {
+					app.Logger.Errorf("event bus stop error: %v", err)
+				}
+				if err := app.Live.Stop(relayCtx); err != nil {
+					app.Logger.Errorf("live hub stop error: %v", err)
+				}
+
+				if app.Config.MetricsPort() != 0 {
+					if err := app.AdminServer.Shutdown(ctx); err != nil {
+						app.Logger.Errorf("admin server shutdown error: %v", err)
+					}
+				}
+
 				return app.Server.Shutdown(ctx)
 			})
 
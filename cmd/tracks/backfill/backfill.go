@@ -0,0 +1,56 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+
+	appshared "hub/internal/application/shared"
+	apptrack "hub/internal/application/track"
+	"hub/internal/domain/station"
+	"hub/internal/wire"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCommand() *cobra.Command {
+	var (
+		batchSize   int
+		overwrite   bool
+		stationSlug string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "backfill-names",
+		Short: "Parse artist and song for stored tracks",
+		Long:  `Parse artist, song and featured artists from the stored stream title of every track of a station that has none yet, and re-derive the artist slugs of the others.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, cleanup, err := wire.InitializeTracksApp()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			defer app.Database.Pool().Close()
+
+			s, err := app.Stations.Get(stationSlug)
+			if err != nil {
+				return fmt.Errorf("station %q: %w", stationSlug, err)
+			}
+
+			ctx := appshared.WithStationID(context.Background(), s.ID())
+			result, err := app.BackfillNames.Handle(ctx, apptrack.BackfillNamesCommand{
+				BatchSize: batchSize,
+				Overwrite: overwrite,
+			})
+			if result != nil {
+				fmt.Printf("Scanned %d tracks, updated %d\n", result.Scanned, result.Updated)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().IntVarP(&batchSize, "batch-size", "b", 500, "Number of tracks to load per batch")
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "Re-parse tracks that already have an artist or song")
+	cmd.Flags().StringVar(&stationSlug, "station", station.DefaultSlug, "Slug of the station whose tracks are parsed")
+
+	return cmd
+}
@@ -0,0 +1,19 @@
+package tracks
+
+import (
+	"github.com/spf13/cobra"
+	"hub/cmd/tracks/backfill"
+)
+
+func NewTracksCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tracks",
+		Short: "Track catalogue maintenance commands",
+		Long:  `Maintain the track catalogue - backfill derived columns`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(backfill.NewCommand())
+
+	return cmd
+}
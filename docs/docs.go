@@ -17,7 +17,7 @@ const docTemplate = `{
     "paths": {
         "/health": {
             "get": {
-                "description": "Check the health status of the service",
+                "description": "Check the health status of the service. The scheduler role and the circuit breaker state of each station's streaming server (streaming_<station>: closed, half-open or open) are informational and don't affect the status.",
                 "produces": ["application/json"],
                 "tags": ["health"],
                 "summary": "Health check",
@@ -65,7 +65,239 @@ const docTemplate = `{
                 }
             }
         },
+        "/artists": {
+            "get": {
+                "description": "List artists derived from parsed track titles, with totals summed over their tracks",
+                "produces": ["application/json"],
+                "tags": ["artists"],
+                "summary": "List artists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive artist name search",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "enum": ["tracks", "rotate", "likes", "dislikes", "listeners", "name"],
+                        "description": "Sort field (default rotate)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "enum": ["asc", "desc"],
+                        "description": "Sort order (default desc, asc for name)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of artists to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Artist page",
+                        "schema": {
+                            "$ref": "#/definitions/ArtistListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query"
+                    }
+                }
+            }
+        },
+        "/artists/{slug}": {
+            "get": {
+                "description": "Get artist totals and a page of their tracks, most rotated first",
+                "produces": ["application/json"],
+                "tags": ["artists"],
+                "summary": "Get artist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "enum": ["rotate", "likes", "dislikes", "listeners", "created_at", "updated_at"],
+                        "description": "Track sort field (default rotate)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "enum": ["asc", "desc"],
+                        "description": "Sort order (default desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Track page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Artist",
+                        "schema": {
+                            "$ref": "#/definitions/ArtistDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid slug or query"
+                    },
+                    "404": {
+                        "description": "Artist not found"
+                    }
+                }
+            }
+        },
+        "/identity": {
+            "post": {
+                "description": "Issue a signed anonymous listener identity. Send the token as 'Authorization: Bearer <token>' when reacting",
+                "produces": ["application/json"],
+                "tags": ["identity"],
+                "summary": "Issue identity",
+                "responses": {
+                    "201": {
+                        "description": "Identity issued",
+                        "schema": {
+                            "$ref": "#/definitions/IdentityResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many identities issued to this client IP, see Retry-After"
+                    }
+                }
+            }
+        },
+        "/stations": {
+            "get": {
+                "description": "List the configured stations. Track, artist, reaction, radio, history, now-playing, live and statistics routes are also served under /stations/{station} for that station; the unprefixed routes serve the default station",
+                "produces": ["application/json"],
+                "tags": ["stations"],
+                "summary": "List stations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/StationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station}/radio/info": {
+            "get": {
+                "description": "Get the stream information of a station, like /radio/info for the default station",
+                "produces": ["application/json"],
+                "tags": ["stations"],
+                "summary": "Get station radio info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Station slug",
+                        "name": "station",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RadioInfoResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Station not found or no active stream"
+                    }
+                }
+            }
+        },
         "/tracks": {
+            "get": {
+                "description": "List the track catalogue with keyset pagination and optional case-insensitive title search",
+                "produces": ["application/json"],
+                "tags": ["tracks"],
+                "summary": "List tracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive title search",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artist slug",
+                        "name": "artist",
+                        "in": "query"
+                    },                    {
+                        "type": "string",
+                        "enum": ["rotate", "likes", "dislikes", "listeners", "created_at", "updated_at"],
+                        "description": "Sort field (default created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "enum": ["asc", "desc"],
+                        "description": "Sort order (default desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track page",
+                        "schema": {
+                            "$ref": "#/definitions/TrackListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query"
+                    }
+                }
+            },
             "post": {
                 "description": "Create or update a track",
                 "consumes": ["application/json"],
@@ -73,6 +305,13 @@ const docTemplate = `{
                 "tags": ["tracks"],
                 "summary": "Upsert track",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the tracks:write scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "description": "Track data",
                         "name": "track",
@@ -92,6 +331,72 @@ const docTemplate = `{
                     },
                     "400": {
                         "description": "Invalid request"
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the tracks:write scope or is restricted to another station"
+                    }
+                }
+            }
+        },
+        "/tracks/{trackId}/reactions/{type}": {
+            "post": {
+                "description": "Add a reaction of any configured type to a track; /like and /dislike are aliases",
+                "tags": ["reactions"],
+                "summary": "React to track",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Track ID",
+                        "name": "trackId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reaction type key, see /reaction-types",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Reaction added"
+                    },
+                    "400": {
+                        "description": "Unknown reaction type"
+                    },
+                    "409": {
+                        "description": "Already reacted"
+                    }
+                }
+            }
+        },
+        "/reaction-types": {
+            "get": {
+                "description": "List the enabled reaction types in display order",
+                "produces": ["application/json"],
+                "tags": ["reactions"],
+                "summary": "List reaction types",
+                "responses": {
+                    "200": {
+                        "description": "Reaction types",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ReactionTypeResponse"
+                            }
+                        }
                     }
                 }
             }
@@ -111,8 +416,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "X-User-ID",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
@@ -142,8 +447,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "X-User-ID",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
@@ -174,8 +479,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "X-User-ID",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
@@ -188,11 +493,82 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "put": {
+                "description": "Switch an existing reaction to another reaction type",
+                "consumes": ["application/json"],
+                "tags": ["reactions"],
+                "summary": "Change reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Track ID",
+                        "name": "trackId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "New reaction",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ChangeReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Reaction changed"
+                    },
+                    "400": {
+                        "description": "Invalid request"
+                    },
+                    "404": {
+                        "description": "Reaction not found"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Retract a reaction",
+                "tags": ["reactions"],
+                "summary": "Remove reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Track ID",
+                        "name": "trackId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer identity token from POST /identity",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Reaction removed"
+                    },
+                    "404": {
+                        "description": "Reaction not found"
+                    }
+                }
             }
         },
         "/radio/info": {
             "get": {
-                "description": "Get current radio stream information",
+                "description": "Get current radio stream information. Listener counts cover every live mount, each listener counted once",
                 "produces": ["application/json"],
                 "tags": ["radio"],
                 "summary": "Get radio info",
@@ -208,7 +584,7 @@ const docTemplate = `{
         },
         "/radio/listeners": {
             "get": {
-                "description": "Get current listener count",
+                "description": "Get current listener count across live mounts, each listener counted once",
                 "produces": ["application/json"],
                 "tags": ["radio"],
                 "summary": "Get listeners",
@@ -222,43 +598,558 @@ const docTemplate = `{
                 }
             }
         },
-        "/radio/statistics": {
+        "/radio/mounts": {
             "get": {
-                "description": "Get track statistics including history, top listened, top rotated, top likes and dislikes",
+                "description": "List the configured mounts, primary first, with the stream details of those that are live",
                 "produces": ["application/json"],
                 "tags": ["radio"],
-                "summary": "Get statistics",
+                "summary": "List mounts",
                 "responses": {
                     "200": {
-                        "description": "Statistics",
+                        "description": "Mounts",
                         "schema": {
-                            "$ref": "#/definitions/StatisticsResponse"
+                            "type": "array",
+                            "items": {"$ref": "#/definitions/MountResponse"}
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "HealthResponse": {
-            "type": "object",
-            "properties": {
-                "status": {"type": "string"},
-                "checks": {"type": "object"}
-            }
-        },
-        "CreateTrackRequest": {
-            "type": "object",
-            "required": ["Md5", "StreamTitle"],
-            "properties": {
-                "Md5": {"type": "string"},
-                "StreamTitle": {"type": "string"},
-                "StreamUrl": {"type": "string"}
-            }
         },
-        "TrackResponse": {
-            "type": "object",
-            "properties": {
+        "/radio/mounts/{mount}/info": {
+            "get": {
+                "description": "Get the stream information of a single mount",
+                "produces": ["application/json"],
+                "tags": ["radio"],
+                "summary": "Get mount info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mount without its leading slash, e.g. mp3",
+                        "name": "mount",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mount info",
+                        "schema": {
+                            "$ref": "#/definitions/RadioInfoResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Mount not configured or not live"
+                    }
+                }
+            }
+        },
+        "/radio/now-playing/stream": {
+            "get": {
+                "description": "Server-Sent Events stream of now-playing frames (event \"now-playing\"), pushed when the track on air, its reactions or the listener count change. Reconnecting clients resume through Last-Event-ID.",
+                "produces": ["text/event-stream"],
+                "tags": ["radio"],
+                "summary": "Stream now playing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the last frame received; missed frames are replayed",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Same as Last-Event-ID, for clients that can't set headers",
+                        "name": "lastEventId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of now-playing frames",
+                        "schema": {
+                            "$ref": "#/definitions/NowPlayingResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "WebSocket gateway. Clients send {\"type\":\"subscribe\",\"id\",\"trackId\"} (empty trackId follows every track) and {\"type\":\"react\",\"id\",\"trackId\",\"reaction\"}; the server answers with ack or error messages carrying the same id, and pushes reaction.added (with the recent count of that reaction) and presence (connection count of the station across replicas) messages. Reacting requires an identity token, passed as a Bearer token or the token query parameter.",
+                "tags": ["radio"],
+                "summary": "Live reactions and presence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity token from POST /identity, for clients that can't set headers",
+                        "name": "token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols"
+                    },
+                    "401": {
+                        "description": "Invalid identity token"
+                    },
+                    "426": {
+                        "description": "Not a WebSocket upgrade"
+                    }
+                }
+            }
+        },
+        "/radio/history": {
+            "get": {
+                "description": "Get past plays, newest first, with listener counts at start and end",
+                "produces": ["application/json"],
+                "tags": ["radio"],
+                "summary": "Get play history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only plays started at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only plays started before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Play history",
+                        "schema": {
+                            "$ref": "#/definitions/HistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query"
+                    }
+                }
+            }
+        },
+        "/radio/statistics": {
+            "get": {
+                "description": "Get track statistics including history, top listened, top rotated, top likes and dislikes, top artists, and the tracks listeners tune in to and out on",
+                "produces": ["application/json"],
+                "tags": ["radio"],
+                "summary": "Get statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "enum": ["today", "7d", "30d", "all"],
+                        "description": "Named period (default all); ignored when from/to are given",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range start (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range end (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics",
+                        "schema": {
+                            "$ref": "#/definitions/StatisticsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/radio/listening": {
+            "get": {
+                "description": "Summarize listener sessions started within the period: session count, average session length and listening hours per day",
+                "produces": ["application/json"],
+                "tags": ["radio"],
+                "summary": "Get listening summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "enum": ["today", "7d", "30d", "all"],
+                        "description": "Named period (default all); ignored when from/to are given",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range start (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range end (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Listening summary",
+                        "schema": {
+                            "$ref": "#/definitions/ListeningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid period"
+                    }
+                }
+            }
+        },
+        "/radio/statistics/{key}": {
+            "get": {
+                "description": "Get one page of a single statistics category",
+                "produces": ["application/json"],
+                "tags": ["radio"],
+                "summary": "Get statistics category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "enum": ["today", "7d", "30d", "all"],
+                        "description": "Named period (default all); ignored when from/to are given",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range start (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Custom range end (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category key (history, listen, rotate, likes, dislikes, artists, tune-ins, tune-outs, tune-out-rate)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (defaults to the category limit, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of tracks to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics category",
+                        "schema": {
+                            "$ref": "#/definitions/Category"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown category"
+                    }
+                }
+            }
+        },
+        "/admin/webhooks": {
+            "get": {
+                "description": "List registered webhooks",
+                "tags": ["webhooks"],
+                "summary": "List webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registered webhooks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a webhook for a set of events; use \"*\" to receive every event. Set station to receive the events of one station only; every delivery names its station in the X-Hub-Station header. The signing secret is only returned once",
+                "tags": ["webhooks"],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook created",
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid URL or events"
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}": {
+            "delete": {
+                "description": "Delete a webhook and its delivery log",
+                "tags": ["webhooks"],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook deleted"
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    },
+                    "404": {
+                        "description": "Webhook not found"
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "List recent deliveries of a webhook, newest first",
+                "tags": ["webhooks"],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, succeeded, dead)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of deliveries (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery log",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status"
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    },
+                    "404": {
+                        "description": "Webhook not found"
+                    }
+                }
+            }
+        },
+        "/admin/jobs": {
+            "get": {
+                "description": "List scheduled jobs with their configuration and last run",
+                "tags": ["jobs"],
+                "summary": "List jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled jobs",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/JobResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{name}/run": {
+            "post": {
+                "description": "Run a job now in the background, even if it is disabled or this replica is not the scheduler leader",
+                "tags": ["jobs"],
+                "summary": "Run job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key with the admin scope",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Job started"
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key"
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope"
+                    },
+                    "404": {
+                        "description": "Job not found"
+                    },
+                    "409": {
+                        "description": "Job is already running and does not allow overlapping runs"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "HealthResponse": {
+            "type": "object",
+            "properties": {
+                "status": {"type": "string"},
+                "checks": {"type": "object"}
+            }
+        },
+        "CreateTrackRequest": {
+            "type": "object",
+            "required": ["Md5", "StreamTitle"],
+            "properties": {
+                "Md5": {"type": "string"},
+                "StreamTitle": {"type": "string"},
+                "StreamUrl": {"type": "string"}
+            }
+        },
+        "TrackResponse": {
+            "type": "object",
+            "properties": {
                 "rotate": {"type": "integer"}
             }
         },
@@ -267,13 +1158,85 @@ const docTemplate = `{
             "properties": {
                 "id": {"type": "string"},
                 "title": {"type": "string"},
+                "artist": {"type": "string"},
+                "song": {"type": "string"},
+                "featuring": {"type": "array", "items": {"type": "string"}},
                 "cover": {"type": "string"},
                 "rotate": {"type": "integer"},
                 "likes": {"type": "integer"},
                 "dislikes": {"type": "integer"},
+                "listeners": {"type": "integer"},
+                "reactions": {"type": "object", "additionalProperties": {"type": "integer"}, "description": "Reaction counts by type"},
+                "tuneOutRate": {"type": "number", "description": "Share of listeners, 0 to 1, who tuned out early in a play of the track; omitted until known"},
+                "createdAt": {"type": "string", "format": "date-time"},
+                "updatedAt": {"type": "string", "format": "date-time"}
+            }
+        },
+        "ArtistResponse": {
+            "type": "object",
+            "properties": {
+                "slug": {"type": "string"},
+                "name": {"type": "string"},
+                "tracks": {"type": "integer"},
+                "rotate": {"type": "integer"},
+                "likes": {"type": "integer"},
+                "dislikes": {"type": "integer"},
                 "listeners": {"type": "integer"}
             }
         },
+        "ArtistListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {"type": "array", "items": {"$ref": "#/definitions/ArtistResponse"}},
+                "limit": {"type": "integer"},
+                "offset": {"type": "integer"},
+                "hasMore": {"type": "boolean"}
+            }
+        },
+        "ArtistDetailResponse": {
+            "type": "object",
+            "properties": {
+                "slug": {"type": "string"},
+                "name": {"type": "string"},
+                "tracks": {"type": "integer"},
+                "rotate": {"type": "integer"},
+                "likes": {"type": "integer"},
+                "dislikes": {"type": "integer"},
+                "listeners": {"type": "integer"},
+                "items": {"type": "array", "items": {"$ref": "#/definitions/GetTrackResponse"}},
+                "nextCursor": {"type": "string"}
+            }
+        },
+        "TrackListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {"type": "array", "items": {"$ref": "#/definitions/GetTrackResponse"}},
+                "nextCursor": {"type": "string"}
+            }
+        },
+        "IdentityResponse": {
+            "type": "object",
+            "properties": {
+                "token": {"type": "string"},
+                "userId": {"type": "string"},
+                "expiresAt": {"type": "string", "format": "date-time"}
+            }
+        },
+        "ReactionTypeResponse": {
+            "type": "object",
+            "properties": {
+                "key": {"type": "string"},
+                "emoji": {"type": "string"},
+                "label": {"type": "string"}
+            }
+        },
+        "ChangeReactionRequest": {
+            "type": "object",
+            "required": ["reaction"],
+            "properties": {
+                "reaction": {"type": "string", "description": "Reaction type key, see /reaction-types"}
+            }
+        },
         "CheckReactionResponse": {
             "type": "object",
             "properties": {
@@ -297,6 +1260,60 @@ const docTemplate = `{
                 "peak": {"type": "integer"}
             }
         },
+        "StationResponse": {
+            "type": "object",
+            "properties": {
+                "slug": {"type": "string"},
+                "name": {"type": "string"},
+                "default": {"type": "boolean"}
+            }
+        },
+        "MountResponse": {
+            "type": "object",
+            "properties": {
+                "mount": {"type": "string"},
+                "live": {"type": "boolean"},
+                "name": {"type": "string"},
+                "description": {"type": "string"},
+                "streamUrl": {"type": "string"},
+                "bitrate": {"type": "string"},
+                "listener": {"$ref": "#/definitions/ListenerResponse"}
+            }
+        },
+        "NowPlayingResponse": {
+            "type": "object",
+            "properties": {
+                "track": {
+                    "$ref": "#/definitions/GetTrackResponse"
+                },
+                "listeners": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "items": {"type": "array", "items": {"$ref": "#/definitions/Play"}},
+                "nextCursor": {"type": "string"}
+            }
+        },
+        "Play": {
+            "type": "object",
+            "properties": {
+                "trackId": {"type": "string"},
+                "title": {"type": "string"},
+                "cover": {"type": "string"},
+                "startedAt": {"type": "string", "format": "date-time"},
+                "endedAt": {"type": "string", "format": "date-time"},
+                "listenersStart": {"type": "integer"},
+                "listenersEnd": {"type": "integer"}
+            }
+        },
         "StatisticsResponse": {
             "type": "object",
             "properties": {
@@ -307,19 +1324,115 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "key": {"type": "string"},
+                "description": {"type": "string"},
                 "icon": {"type": "string"},
-                "tracks": {"type": "array", "items": {"$ref": "#/definitions/TrackStats"}}
+                "tracks": {"type": "array", "items": {"$ref": "#/definitions/TrackStats"}},
+                "limit": {"type": "integer"},
+                "offset": {"type": "integer"},
+                "hasMore": {"type": "boolean"}
             }
         },
         "TrackStats": {
             "type": "object",
             "properties": {
                 "title": {"type": "string"},
+                "artist": {"type": "string"},
+                "slug": {"type": "string", "description": "Artist slug, set by artist categories"},
+                "song": {"type": "string"},
                 "cover": {"type": "string"},
                 "rotate": {"type": "integer"},
                 "likes": {"type": "integer"},
                 "dislikes": {"type": "integer"},
-                "listeners": {"type": "integer"}
+                "listeners": {"type": "integer"},
+                "count": {"type": "integer", "description": "Category metric within the requested period"}
+            }
+        },
+        "CreateWebhookRequest": {
+            "type": "object",
+            "required": ["url", "events"],
+            "properties": {
+                "url": {"type": "string"},
+                "events": {"type": "array", "items": {"type": "string"}},
+                "station": {"type": "string", "description": "Slug of the only station to receive events of; every station when omitted"}
+            }
+        },
+        "WebhookResponse": {
+            "type": "object",
+            "properties": {
+                "id": {"type": "string"},
+                "url": {"type": "string"},
+                "events": {"type": "array", "items": {"type": "string"}},
+                "station": {"type": "string", "description": "Omitted for every station"},
+                "createdAt": {"type": "string", "format": "date-time"}
+            }
+        },
+        "CreateWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "id": {"type": "string"},
+                "url": {"type": "string"},
+                "events": {"type": "array", "items": {"type": "string"}},
+                "station": {"type": "string", "description": "Omitted for every station"},
+                "createdAt": {"type": "string", "format": "date-time"},
+                "secret": {"type": "string", "description": "HMAC secret used for the X-Hub-Signature header"}
+            }
+        },
+        "WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {"type": "integer"},
+                "event": {"type": "string"},
+                "station": {"type": "string", "description": "Station the event happened on"},
+                "payload": {"type": "object"},
+                "status": {"type": "string"},
+                "attempts": {"type": "integer"},
+                "responseStatus": {"type": "integer"},
+                "lastError": {"type": "string"},
+                "nextAttemptAt": {"type": "string", "format": "date-time"},
+                "createdAt": {"type": "string", "format": "date-time"},
+                "deliveredAt": {"type": "string", "format": "date-time"}
+            }
+        },
+        "JobResponse": {
+            "type": "object",
+            "properties": {
+                "name": {"type": "string"},
+                "spec": {"type": "string", "description": "Cron spec with seconds"},
+                "enabled": {"type": "boolean"},
+                "overlap": {"type": "string", "description": "skip, delay or allow"},
+                "timeout": {"type": "string"},
+                "running": {"type": "boolean"},
+                "nextRunAt": {"type": "string", "format": "date-time"},
+                "lastRun": {"$ref": "#/definitions/JobRunResponse"}
+            }
+        },
+        "JobRunResponse": {
+            "type": "object",
+            "properties": {
+                "id": {"type": "integer"},
+                "trigger": {"type": "string", "description": "schedule or manual"},
+                "startedAt": {"type": "string", "format": "date-time"},
+                "finishedAt": {"type": "string", "format": "date-time"},
+                "error": {"type": "string"}
+            }
+        },
+        "ListeningResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {"type": "integer"},
+                "averageSessionSeconds": {"type": "integer", "description": "Average length of ended sessions"},
+                "listeningHours": {"type": "number"},
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "date": {"type": "string", "format": "date"},
+                            "sessions": {"type": "integer", "description": "Sessions started that day"},
+                            "hours": {"type": "number"}
+                        }
+                    }
+                }
             }
         }
     }
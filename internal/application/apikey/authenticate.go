@@ -0,0 +1,69 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hub/internal/domain/apikey"
+	"hub/internal/domain/shared"
+	"hub/internal/logger"
+)
+
+var (
+	// ErrScopeNotGranted is returned when a valid key lacks the scope a route requires.
+	ErrScopeNotGranted = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"API key does not grant the required scope",
+	)
+	// ErrStationNotGranted is returned when a valid key is restricted to another station.
+	ErrStationNotGranted = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"API key does not grant the station",
+	)
+)
+
+// AuthenticateHandler handles the authenticate API key use case.
+type AuthenticateHandler struct {
+	repo   apikey.Repository
+	logger *logger.Logger
+}
+
+// NewAuthenticateHandler creates a new AuthenticateHandler.
+func NewAuthenticateHandler(repo apikey.Repository, log *logger.Logger) *AuthenticateHandler {
+	return &AuthenticateHandler{repo: repo, logger: log}
+}
+
+// Handle resolves the key for a secret and checks it grants the scope and station.
+// Returns ErrInvalidAPIKey for unknown or revoked keys, ErrScopeNotGranted
+// for keys without the scope and ErrStationNotGranted for keys of another station.
+func (h *AuthenticateHandler) Handle(ctx context.Context, query AuthenticateQuery) (*APIKeyDTO, error) {
+	scope, err := apikey.NewScope(query.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := h.repo.FindByHash(ctx, apikey.HashSecret(query.Secret))
+	if err != nil {
+		if errors.Is(err, apikey.ErrAPIKeyNotFound) {
+			return nil, apikey.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return nil, apikey.ErrInvalidAPIKey
+	}
+	if !key.Allows(scope) {
+		return nil, ErrScopeNotGranted
+	}
+	if !key.AllowsStation(query.StationID) {
+		return nil, ErrStationNotGranted
+	}
+
+	// Usage tracking is best effort and must not reject an authorised request
+	if err := h.repo.MarkUsed(ctx, key.ID(), time.Now()); err != nil {
+		h.logger.WithContext("apikey", "authenticate").WithError(err).Warn("Failed to record API key usage")
+	}
+
+	return newAPIKeyDTO(key), nil
+}
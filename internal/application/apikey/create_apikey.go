@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"context"
+
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/apikey"
+)
+
+// CreateAPIKeyHandler handles the create API key use case.
+type CreateAPIKeyHandler struct {
+	repo     apikey.Repository
+	stations *appstation.Registry
+}
+
+// NewCreateAPIKeyHandler creates a new CreateAPIKeyHandler.
+func NewCreateAPIKeyHandler(repo apikey.Repository, stations *appstation.Registry) *CreateAPIKeyHandler {
+	return &CreateAPIKeyHandler{repo: repo, stations: stations}
+}
+
+// Handle executes the create API key use case.
+func (h *CreateAPIKeyHandler) Handle(ctx context.Context, cmd CreateAPIKeyCommand) (*CreateAPIKeyResult, error) {
+	scopes := make([]apikey.Scope, 0, len(cmd.Scopes))
+	for _, s := range cmd.Scopes {
+		scope, err := apikey.NewScope(s)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+
+	var stationID int64
+	if cmd.Station != "" {
+		s, err := h.stations.Get(cmd.Station)
+		if err != nil {
+			return nil, err
+		}
+		stationID = s.ID()
+	}
+
+	key, secret, err := apikey.Generate(cmd.Name, scopes, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &CreateAPIKeyResult{Key: newAPIKeyDTO(key), Secret: secret}, nil
+}
@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"time"
+
+	"hub/internal/domain/apikey"
+)
+
+// CreateAPIKeyCommand represents the command to create an API key.
+type CreateAPIKeyCommand struct {
+	Name    string
+	Scopes  []string // tracks:write or admin
+	Station string   // slug of the only station granted, empty for every station
+}
+
+// CreateAPIKeyResult represents a newly created API key.
+// Secret is only available at creation time.
+type CreateAPIKeyResult struct {
+	Key    *APIKeyDTO
+	Secret string
+}
+
+// RevokeAPIKeyCommand represents the command to revoke an API key.
+type RevokeAPIKeyCommand struct {
+	ID string
+}
+
+// AuthenticateQuery represents the query to authorise a request made with an API key.
+type AuthenticateQuery struct {
+	Secret    string
+	Scope     string
+	StationID int64
+}
+
+// APIKeyDTO represents an API key for external use. It never carries the secret.
+type APIKeyDTO struct {
+	ID         string
+	Name       string
+	Prefix     string
+	Scopes     []string
+	StationID  int64 // 0 for every station
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// newAPIKeyDTO maps an APIKey entity to an APIKeyDTO.
+func newAPIKeyDTO(k *apikey.APIKey) *APIKeyDTO {
+	scopes := make([]string, len(k.Scopes()))
+	for i, s := range k.Scopes() {
+		scopes[i] = s.String()
+	}
+
+	return &APIKeyDTO{
+		ID:         k.ID(),
+		Name:       k.Name(),
+		Prefix:     k.Prefix(),
+		Scopes:     scopes,
+		StationID:  k.StationID(),
+		CreatedAt:  k.CreatedAt(),
+		LastUsedAt: k.LastUsedAt(),
+		RevokedAt:  k.RevokedAt(),
+	}
+}
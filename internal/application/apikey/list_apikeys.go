@@ -0,0 +1,31 @@
+package apikey
+
+import (
+	"context"
+
+	"hub/internal/domain/apikey"
+)
+
+// ListAPIKeysHandler handles the list API keys use case.
+type ListAPIKeysHandler struct {
+	repo apikey.Repository
+}
+
+// NewListAPIKeysHandler creates a new ListAPIKeysHandler.
+func NewListAPIKeysHandler(repo apikey.Repository) *ListAPIKeysHandler {
+	return &ListAPIKeysHandler{repo: repo}
+}
+
+// Handle executes the list API keys use case.
+func (h *ListAPIKeysHandler) Handle(ctx context.Context) ([]*APIKeyDTO, error) {
+	keys, err := h.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*APIKeyDTO, len(keys))
+	for i, k := range keys {
+		result[i] = newAPIKeyDTO(k)
+	}
+	return result, nil
+}
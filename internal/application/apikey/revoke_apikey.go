@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/domain/apikey"
+
+	"github.com/google/uuid"
+)
+
+// RevokeAPIKeyHandler handles the revoke API key use case.
+type RevokeAPIKeyHandler struct {
+	repo apikey.Repository
+}
+
+// NewRevokeAPIKeyHandler creates a new RevokeAPIKeyHandler.
+func NewRevokeAPIKeyHandler(repo apikey.Repository) *RevokeAPIKeyHandler {
+	return &RevokeAPIKeyHandler{repo: repo}
+}
+
+// Handle executes the revoke API key use case.
+func (h *RevokeAPIKeyHandler) Handle(ctx context.Context, cmd RevokeAPIKeyCommand) error {
+	if _, err := uuid.Parse(cmd.ID); err != nil {
+		return apikey.ErrAPIKeyNotFound
+	}
+
+	key, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := key.Revoke(time.Now()); err != nil {
+		return err
+	}
+
+	return h.repo.Save(ctx, key)
+}
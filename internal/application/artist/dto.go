@@ -0,0 +1,51 @@
+package artist
+
+import (
+	"hub/internal/domain/artist"
+)
+
+// ListArtistsQuery represents the query to list and search artists.
+type ListArtistsQuery struct {
+	Search string
+	Sort   string // tracks, rotate, likes, dislikes, listeners or name
+	Order  string // "asc" or "desc"
+	Limit  int
+	Offset int
+}
+
+// ArtistListResult represents a page of artists.
+type ArtistListResult struct {
+	Artists []*ArtistDTO
+	Limit   int
+	Offset  int
+	HasMore bool
+}
+
+// GetArtistQuery represents the query to get an artist.
+type GetArtistQuery struct {
+	Slug string
+}
+
+// ArtistDTO represents an artist with totals over their tracks.
+type ArtistDTO struct {
+	Slug      string
+	Name      string
+	Tracks    int
+	Rotate    int
+	Likes     int
+	Dislikes  int
+	Listeners int
+}
+
+// newArtistDTO maps an Artist read model to an ArtistDTO.
+func newArtistDTO(a *artist.Artist) *ArtistDTO {
+	return &ArtistDTO{
+		Slug:      a.Slug().String(),
+		Name:      a.Name(),
+		Tracks:    a.TrackCount(),
+		Rotate:    a.Rotate(),
+		Likes:     a.Likes(),
+		Dislikes:  a.Dislikes(),
+		Listeners: a.Listeners(),
+	}
+}
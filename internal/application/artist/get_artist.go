@@ -0,0 +1,32 @@
+package artist
+
+import (
+	"context"
+
+	"hub/internal/domain/artist"
+)
+
+// GetArtistHandler handles the get artist use case.
+type GetArtistHandler struct {
+	repo artist.Repository
+}
+
+// NewGetArtistHandler creates a new GetArtistHandler.
+func NewGetArtistHandler(repo artist.Repository) *GetArtistHandler {
+	return &GetArtistHandler{repo: repo}
+}
+
+// Handle executes the get artist use case.
+func (h *GetArtistHandler) Handle(ctx context.Context, query GetArtistQuery) (*ArtistDTO, error) {
+	slug, err := artist.ParseSlug(query.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := h.repo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return newArtistDTO(a), nil
+}
@@ -0,0 +1,88 @@
+package artist
+
+import (
+	"context"
+	"strings"
+
+	"hub/internal/domain/artist"
+	"hub/internal/domain/shared"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ErrInvalidSortOrder is returned when the sort order is neither asc nor desc.
+var ErrInvalidSortOrder = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"order must be 'asc' or 'desc'",
+)
+
+// ListArtistsHandler handles the list artists use case.
+type ListArtistsHandler struct {
+	repo artist.Repository
+}
+
+// NewListArtistsHandler creates a new ListArtistsHandler.
+func NewListArtistsHandler(repo artist.Repository) *ListArtistsHandler {
+	return &ListArtistsHandler{repo: repo}
+}
+
+// Handle executes the list artists use case.
+func (h *ListArtistsHandler) Handle(ctx context.Context, query ListArtistsQuery) (*ArtistListResult, error) {
+	sortBy, err := artist.NewSortField(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	var descending bool
+	switch query.Order {
+	case "":
+		// Totals read best largest first, names alphabetically.
+		descending = sortBy != artist.SortByName
+	case "desc":
+		descending = true
+	case "asc":
+		descending = false
+	default:
+		return nil, ErrInvalidSortOrder
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	artists, err := h.repo.FindByCriteria(ctx, artist.Criteria{
+		Search:     strings.TrimSpace(query.Search),
+		SortBy:     sortBy,
+		Descending: descending,
+		// Fetch one extra row to know whether another page exists.
+		Limit:  limit + 1,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ArtistListResult{Limit: limit, Offset: offset}
+	if len(artists) > limit {
+		artists = artists[:limit]
+		result.HasMore = true
+	}
+
+	result.Artists = make([]*ArtistDTO, len(artists))
+	for i, a := range artists {
+		result.Artists[i] = newArtistDTO(a)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package identity
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/domain/reaction"
+	"hub/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidToken is returned when an identity token is malformed, forged or expired.
+var ErrInvalidToken = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"invalid identity token",
+)
+
+// Claims is the content of an identity token.
+// A zero ExpiresAt means the token does not expire.
+type Claims struct {
+	Subject   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Signer encodes claims into a tamper-proof token and back.
+type Signer interface {
+	Sign(claims Claims) (string, error)
+
+	// Verify returns the claims of a token whose signature is valid.
+	// Expiry is checked by the service.
+	Verify(token string) (Claims, error)
+}
+
+// Token represents an issued identity token.
+type Token struct {
+	Value     string
+	UserID    string
+	ExpiresAt *time.Time
+}
+
+// Service defines the anonymous listener identity service interface.
+type Service interface {
+	// Issue creates a new anonymous identity and its signed token.
+	Issue(ctx context.Context) (*Token, error)
+
+	// Verify checks a token and returns the trusted user ID it was issued for.
+	// Returns ErrInvalidToken if the token is forged or expired.
+	Verify(ctx context.Context, token string) (reaction.UserID, error)
+}
+
+type service struct {
+	signer Signer
+	ttl    time.Duration
+}
+
+// NewService creates a new identity service.
+// Tokens expire after ttl; a zero ttl issues tokens that never expire.
+func NewService(signer Signer, ttl time.Duration) Service {
+	return &service{signer: signer, ttl: ttl}
+}
+
+func (s *service) Issue(ctx context.Context) (*Token, error) {
+	now := time.Now().UTC()
+	claims := Claims{
+		Subject:  uuid.New().String(),
+		IssuedAt: now,
+	}
+	if s.ttl > 0 {
+		claims.ExpiresAt = now.Add(s.ttl)
+	}
+
+	value, err := s.signer.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{Value: value, UserID: claims.Subject}
+	if !claims.ExpiresAt.IsZero() {
+		token.ExpiresAt = &claims.ExpiresAt
+	}
+	return token, nil
+}
+
+func (s *service) Verify(ctx context.Context, token string) (reaction.UserID, error) {
+	claims, err := s.signer.Verify(token)
+	if err != nil {
+		return reaction.UserID{}, ErrInvalidToken
+	}
+	if !claims.ExpiresAt.IsZero() && time.Now().After(claims.ExpiresAt) {
+		return reaction.UserID{}, ErrInvalidToken
+	}
+
+	userID, err := reaction.NewUserID(claims.Subject)
+	if err != nil {
+		return reaction.UserID{}, ErrInvalidToken
+	}
+	return userID, nil
+}
@@ -4,12 +4,19 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
 
-	"hub/internal/infrastructure/icecast"
+	appshared "hub/internal/application/shared"
+	"hub/internal/infrastructure/streaming"
 	"hub/internal/logger"
 )
 
+// ErrCountUnavailable is returned when the listener count couldn't be read
+// at all, as opposed to failures recording listeners of a known count.
+var ErrCountUnavailable = errors.New("listener count unavailable")
+
 // Repository defines the listener repository interface.
 type Repository interface {
 	TrackListener(ctx context.Context, userID, trackID string) error
@@ -24,60 +31,111 @@ type TrackRepository interface {
 
 // Service defines the listener service interface.
 type Service interface {
-	TrackCurrentListeners(ctx context.Context) error
+	// TrackCurrentListeners records the listeners of the track on air of the
+	// station the context is scoped to and returns the number of listeners
+	// currently connected to any of its mounts. When the server doesn't list
+	// individual listeners, only the count is returned, summed over mounts.
+	// The count is meaningless if the error is ErrCountUnavailable.
+	TrackCurrentListeners(ctx context.Context) (int, error)
 }
 
 type service struct {
-	icecastClient icecast.Client
-	listenerRepo  Repository
-	trackRepo     TrackRepository
-	logger        *logger.Logger
+	clients      streaming.Clients
+	listenerRepo Repository
+	trackRepo    TrackRepository
+	sessions     *SessionTracker
+	logger       *logger.Logger
 }
 
 // NewService creates a new listener service.
 func NewService(
-	icecastClient icecast.Client,
+	clients streaming.Clients,
 	listenerRepo Repository,
 	trackRepo TrackRepository,
+	sessions *SessionTracker,
 	log *logger.Logger,
 ) Service {
 	return &service{
-		icecastClient: icecastClient,
-		listenerRepo:  listenerRepo,
-		trackRepo:     trackRepo,
-		logger:        log,
+		clients:      clients,
+		listenerRepo: listenerRepo,
+		trackRepo:    trackRepo,
+		sessions:     sessions,
+		logger:       log,
 	}
 }
 
-func (s *service) TrackCurrentListeners(ctx context.Context) error {
+func (s *service) TrackCurrentListeners(ctx context.Context) (int, error) {
 	log := s.logger.WithContext("listener", "track_current")
 
-	stats, err := s.icecastClient.MountStats()
+	client, err := s.clients.Get(appshared.StationID(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrCountUnavailable, err)
+	}
+
+	stats, err := client.MountStats(ctx)
 	if err != nil {
 		log.WithError(err).Error("failed to get mount stats")
-		return fmt.Errorf("failed to get mount stats: %w", err)
+		return 0, fmt.Errorf("%w: failed to get mount stats: %w", ErrCountUnavailable, err)
+	}
+	// Until the client lists are merged, listeners on several mounts count once per mount
+	active := 0
+	trackID := ""
+	for _, source := range stats {
+		active += source.Listeners
+		if trackID == "" {
+			trackID = streaming.ExtractTrackID(source.Title)
+		}
 	}
 
-	trackID := icecast.ExtractTrackID(stats.Title)
+	// A failed track lookup leaves the track unknown but the listeners
+	// are still counted, so the count returned is never a partial one
+	var trackErr error
 	if trackID == "" {
 		log.Debug("no track ID in stream title")
-		return nil
+	} else {
+		exists, err := s.trackRepo.ExistsByID(ctx, trackID)
+		if err != nil {
+			log.WithError(err).Error("failed to check if track exists")
+			trackErr = fmt.Errorf("failed to check if track exists: %w", err)
+			trackID = ""
+		} else if !exists {
+			log.WithField("track_id", trackID).Debug("track not found, skipping")
+			trackID = ""
+		}
 	}
 
-	exists, err := s.trackRepo.ExistsByID(ctx, trackID)
-	if err != nil {
-		log.WithError(err).Error("failed to check if track exists")
-		return fmt.Errorf("failed to check if track exists: %w", err)
+	// A mount whose list can't be read is skipped; its listeners are
+	// counted from its stats, once per mount
+	lists := make([]*streaming.ClientList, 0, len(stats))
+	unlisted := 0
+	for _, source := range stats {
+		list, err := client.ListClients(ctx, source.Mount)
+		if errors.Is(err, streaming.ErrListenersUnavailable) {
+			log.Debug("listener list unavailable, listeners and sessions not tracked")
+			return active, trackErr
+		}
+		if err != nil {
+			log.WithError(err).WithField("mount", source.Mount).Warn("failed to get client list, skipping mount")
+			unlisted += source.Listeners
+			continue
+		}
+		lists = append(lists, list)
 	}
-	if !exists {
-		log.WithField("track_id", trackID).Debug("track not found, skipping")
-		return nil
+	clientList := streaming.MergeClientLists(lists...)
+	active = clientList.Count + unlisted
+
+	// Sessions are tracked even when the track on air is unknown, but not
+	// from a partial list, which would end the sessions of skipped mounts
+	recordErr := trackErr
+	if len(lists) == len(stats) {
+		if err := s.sessions.Observe(ctx, clientList.Listeners, trackID, time.Now()); err != nil {
+			log.WithError(err).Error("failed to update listener sessions")
+			recordErr = errors.Join(recordErr, fmt.Errorf("failed to update listener sessions: %w", err))
+		}
 	}
 
-	clientList, err := s.icecastClient.ListClients()
-	if err != nil {
-		log.WithError(err).Error("failed to get client list")
-		return fmt.Errorf("failed to get client list: %w", err)
+	if trackID == "" {
+		return active, recordErr
 	}
 
 	for _, l := range clientList.Listeners {
@@ -90,7 +148,7 @@ func (s *service) TrackCurrentListeners(ctx context.Context) error {
 	count, err := s.listenerRepo.GetUniqueListenerCount(ctx, trackID)
 	if err != nil {
 		log.WithError(err).Error("failed to get listener count")
-		return fmt.Errorf("failed to get listener count: %w", err)
+		return active, errors.Join(recordErr, fmt.Errorf("failed to get listener count: %w", err))
 	}
 
 	log.WithFields(map[string]interface{}{
@@ -98,7 +156,7 @@ func (s *service) TrackCurrentListeners(ctx context.Context) error {
 		"listener_count": count,
 	}).Debug("updated listener count")
 
-	return s.trackRepo.UpdateListenerCount(ctx, trackID, count)
+	return active, errors.Join(recordErr, s.trackRepo.UpdateListenerCount(ctx, trackID, count))
 }
 
 func generateUserID(ip, userAgent string, icecastID int) string {
@@ -0,0 +1,94 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainlistener "hub/internal/domain/listener"
+	"hub/internal/infrastructure/streaming"
+)
+
+// SessionTracker reconstructs listening sessions from successive polls of
+// the streaming server client list. Open sessions live in the repository, so
+// tracking carries on when another replica takes over polling.
+type SessionTracker struct {
+	repo domainlistener.SessionRepository
+}
+
+// NewSessionTracker creates a new SessionTracker.
+func NewSessionTracker(repo domainlistener.SessionRepository) *SessionTracker {
+	return &SessionTracker{repo: repo}
+}
+
+// Observe opens a session for every newly connected client, extends the
+// sessions of clients still connected, and closes those of clients gone
+// since the previous poll. trackID is the track on air, or "" if unknown.
+func (t *SessionTracker) Observe(ctx context.Context, listeners []streaming.Listener, trackID string, now time.Time) error {
+	sessions, err := t.repo.FindOpen(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load open sessions: %w", err)
+	}
+
+	open := make(map[string]*domainlistener.Session, len(sessions))
+	for _, s := range sessions {
+		open[s.UserID()] = s
+	}
+
+	var (
+		errs     []error
+		extended []int64
+		seen     = make(map[string]bool, len(listeners))
+	)
+
+	for _, l := range listeners {
+		userID := generateUserID(l.IP, l.UserAgent, l.ID)
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		connectedAt := now.Add(-time.Duration(l.Connected) * time.Second)
+
+		if s, ok := open[userID]; ok {
+			if s.ContinuesWith(connectedAt, now) {
+				extended = append(extended, s.ID())
+				continue
+			}
+			// Same client ID, new connection: end the old session first
+			s.Close()
+			if err := t.repo.Close(ctx, s); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		s, err := domainlistener.NewSession(userID, l.ID, connectedAt, now, trackID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := t.repo.Open(ctx, s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for userID, s := range open {
+		if seen[userID] {
+			continue
+		}
+		s.Close()
+		if err := t.repo.Close(ctx, s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(extended) > 0 {
+		if err := t.repo.Extend(ctx, extended, now, trackID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,174 @@
+package listener
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainlistener "hub/internal/domain/listener"
+	"hub/internal/infrastructure/streaming"
+)
+
+// memorySessionRepository keeps sessions in memory, handing out copies as
+// the database would.
+type memorySessionRepository struct {
+	sessions []*domainlistener.Session
+	opens    int
+}
+
+func (r *memorySessionRepository) FindOpen(_ context.Context) ([]*domainlistener.Session, error) {
+	var open []*domainlistener.Session
+	for _, s := range r.sessions {
+		if !s.IsClosed() {
+			open = append(open, copySession(s.ID(), s))
+		}
+	}
+	return open, nil
+}
+
+func (r *memorySessionRepository) Open(_ context.Context, session *domainlistener.Session) error {
+	r.opens++
+	if r.find(session.UserID()) != nil {
+		return nil
+	}
+	r.sessions = append(r.sessions, copySession(int64(len(r.sessions)+1), session))
+	return nil
+}
+
+func (r *memorySessionRepository) Extend(_ context.Context, ids []int64, seenAt time.Time, trackID string) error {
+	for _, id := range ids {
+		r.sessions[id-1].Extend(seenAt, trackID)
+	}
+	return nil
+}
+
+func (r *memorySessionRepository) Close(_ context.Context, session *domainlistener.Session) error {
+	r.sessions[session.ID()-1] = copySession(session.ID(), session)
+	return nil
+}
+
+// find returns the open session of a listener, or nil.
+func (r *memorySessionRepository) find(userID string) *domainlistener.Session {
+	for _, s := range r.sessions {
+		if s.UserID() == userID && !s.IsClosed() {
+			return s
+		}
+	}
+	return nil
+}
+
+func copySession(id int64, s *domainlistener.Session) *domainlistener.Session {
+	return domainlistener.ReconstructSession(id, s.UserID(), s.ClientID(), s.StartedAt(), s.LastSeenAt(), s.EndedAt(), s.FirstTrackID(), s.LastTrackID())
+}
+
+func TestSessionTrackerObserve(t *testing.T) {
+	const (
+		trackA = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+		trackB = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	)
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	alice := streaming.Listener{ID: 1, IP: "192.0.2.1", UserAgent: "VLC"}
+	bob := streaming.Listener{ID: 2, IP: "192.0.2.2", UserAgent: "mpv"}
+
+	// connected returns a listener as seen after being connected for d.
+	connected := func(l streaming.Listener, d time.Duration) streaming.Listener {
+		l.Connected = int(d / time.Second)
+		return l
+	}
+
+	t.Run("extends a continuing connection", func(t *testing.T) {
+		repo := &memorySessionRepository{}
+		tracker := NewSessionTracker(repo)
+		ctx := context.Background()
+
+		if err := tracker.Observe(ctx, []streaming.Listener{connected(alice, 5*time.Second)}, trackA, start); err != nil {
+			t.Fatalf("first poll: %v", err)
+		}
+		if err := tracker.Observe(ctx, []streaming.Listener{connected(alice, 20*time.Second)}, trackB, start.Add(15*time.Second)); err != nil {
+			t.Fatalf("second poll: %v", err)
+		}
+
+		if len(repo.sessions) != 1 {
+			t.Fatalf("got %d sessions, want 1", len(repo.sessions))
+		}
+		s := repo.sessions[0]
+		if s.IsClosed() {
+			t.Error("session was closed")
+		}
+		if !s.LastSeenAt().Equal(start.Add(15 * time.Second)) {
+			t.Errorf("last seen = %s, want the second poll", s.LastSeenAt())
+		}
+		if s.FirstTrackID() != trackA || s.LastTrackID() != trackB {
+			t.Errorf("tracks = %q..%q, want %q..%q", s.FirstTrackID(), s.LastTrackID(), trackA, trackB)
+		}
+	})
+
+	t.Run("client ID reused by a new connection", func(t *testing.T) {
+		repo := &memorySessionRepository{}
+		tracker := NewSessionTracker(repo)
+		ctx := context.Background()
+
+		if err := tracker.Observe(ctx, []streaming.Listener{connected(alice, 5*time.Minute)}, trackA, start); err != nil {
+			t.Fatalf("first poll: %v", err)
+		}
+		// The same client ID, but connected only since just before this poll
+		if err := tracker.Observe(ctx, []streaming.Listener{connected(alice, 5*time.Second)}, trackB, start.Add(2*time.Minute)); err != nil {
+			t.Fatalf("second poll: %v", err)
+		}
+
+		if len(repo.sessions) != 2 {
+			t.Fatalf("got %d sessions, want 2", len(repo.sessions))
+		}
+		old, current := repo.sessions[0], repo.sessions[1]
+		if !old.IsClosed() {
+			t.Error("session of the old connection is still open")
+		} else if !old.EndedAt().Equal(start) {
+			t.Errorf("old session ended at %s, want when last seen: %s", old.EndedAt(), start)
+		}
+		if current.IsClosed() {
+			t.Error("session of the new connection is closed")
+		}
+		if current.FirstTrackID() != trackB {
+			t.Errorf("new session tuned in to %q, want %q", current.FirstTrackID(), trackB)
+		}
+	})
+
+	t.Run("duplicate client in one poll", func(t *testing.T) {
+		repo := &memorySessionRepository{}
+		tracker := NewSessionTracker(repo)
+
+		listeners := []streaming.Listener{connected(alice, 5*time.Second), connected(alice, 5*time.Second)}
+		if err := tracker.Observe(context.Background(), listeners, trackA, start); err != nil {
+			t.Fatalf("Observe: %v", err)
+		}
+
+		if repo.opens != 1 {
+			t.Errorf("opened %d sessions, want 1", repo.opens)
+		}
+	})
+
+	t.Run("client gone since the last poll", func(t *testing.T) {
+		repo := &memorySessionRepository{}
+		tracker := NewSessionTracker(repo)
+		ctx := context.Background()
+
+		first := []streaming.Listener{connected(alice, 5*time.Second), connected(bob, 5*time.Second)}
+		if err := tracker.Observe(ctx, first, trackA, start); err != nil {
+			t.Fatalf("first poll: %v", err)
+		}
+		if err := tracker.Observe(ctx, []streaming.Listener{connected(alice, 20*time.Second)}, trackA, start.Add(15*time.Second)); err != nil {
+			t.Fatalf("second poll: %v", err)
+		}
+
+		if repo.find(generateUserID(alice.IP, alice.UserAgent, alice.ID)) == nil {
+			t.Error("session of the remaining client was closed")
+		}
+		if repo.find(generateUserID(bob.IP, bob.UserAgent, bob.ID)) != nil {
+			t.Fatal("session of the departed client is still open")
+		}
+		gone := repo.sessions[1]
+		if !gone.EndedAt().Equal(start) {
+			t.Errorf("departed session ended at %s, want when last seen: %s", gone.EndedAt(), start)
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package nowplaying
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	apptrack "hub/internal/application/track"
+	"hub/internal/domain/listener"
+	domainplay "hub/internal/domain/play"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/track"
+	"hub/internal/logger"
+)
+
+const (
+	// historySize is how many frames are kept for Last-Event-ID resumes.
+	historySize = 64
+	// subscriberBuffer is how many frames a subscriber may lag behind
+	// before it is dropped and has to reconnect.
+	subscriberBuffer = 16
+)
+
+// NowPlaying is the state pushed to now-playing subscribers.
+type NowPlaying struct {
+	Track     *apptrack.TrackDTO
+	Listeners int
+	UpdatedAt time.Time
+}
+
+// Frame is a numbered now-playing update.
+type Frame struct {
+	ID         uint64
+	NowPlaying NowPlaying
+}
+
+// Subscription receives now-playing frames until it is closed.
+type Subscription struct {
+	// Replay holds the frames the subscriber missed, oldest first.
+	Replay []Frame
+
+	frames      chan Frame
+	channel     *channel
+	broadcaster *Broadcaster
+}
+
+// Frames returns the channel of live frames.
+// It is closed when the subscriber falls too far behind.
+func (s *Subscription) Frames() <-chan Frame {
+	return s.frames
+}
+
+// Close detaches the subscription from the broadcaster.
+func (s *Subscription) Close() {
+	s.broadcaster.unsubscribe(s)
+}
+
+// Broadcaster keeps the now-playing state of every station and fans changes
+// out to the subscribers of that station. It is driven by track, reaction
+// and listener count events, each scoped to a station by its context.
+type Broadcaster struct {
+	tracks *apptrack.GetTrackHandler
+	plays  domainplay.Repository
+	logger *logger.Logger
+
+	mu       sync.Mutex
+	channels map[int64]*channel
+}
+
+// channel is the now-playing state of one station.
+type channel struct {
+	current NowPlaying
+	// When the event that switched to, and last refreshed, the current
+	// track occurred; older events arriving late are ignored
+	switchedAt  time.Time
+	refreshedAt time.Time
+	// When the listener count was taken
+	countedAt time.Time
+
+	seq         uint64
+	history     []Frame
+	subscribers map[*Subscription]struct{}
+}
+
+// NewBroadcaster creates a new Broadcaster.
+func NewBroadcaster(tracks *apptrack.GetTrackHandler, plays domainplay.Repository, log *logger.Logger) *Broadcaster {
+	return &Broadcaster{
+		tracks:   tracks,
+		plays:    plays,
+		logger:   log,
+		channels: make(map[int64]*channel),
+	}
+}
+
+// channel returns the state of the station ctx is scoped to. Callers hold b.mu.
+func (b *Broadcaster) channel(ctx context.Context) *channel {
+	stationID := appshared.StationID(ctx)
+	ch, ok := b.channels[stationID]
+	if !ok {
+		ch = &channel{
+			// Start from the clock so IDs issued before a restart are older than
+			// the new ones and clients resuming with them get a fresh snapshot.
+			seq:         uint64(time.Now().UnixMilli()),
+			subscribers: make(map[*Subscription]struct{}),
+		}
+		b.channels[stationID] = ch
+	}
+	return ch
+}
+
+// Seed loads the track on air of the station ctx is scoped to from the play
+// history, so subscribers connecting after a restart get a snapshot before
+// the next track change.
+func (b *Broadcaster) Seed(ctx context.Context) error {
+	p, err := b.plays.FindCurrent(ctx)
+	if errors.Is(err, domainplay.ErrPlayNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	t, err := b.tracks.Handle(ctx, apptrack.GetTrackQuery{ID: p.TrackID().String()})
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := b.channel(ctx)
+	if !p.StartedAt().After(ch.switchedAt) {
+		return nil
+	}
+	ch.switchedAt, ch.refreshedAt = p.StartedAt(), p.StartedAt()
+
+	next := ch.current
+	next.Track = t
+	ch.emit(next)
+	return nil
+}
+
+// HandleEvent refreshes the track on air for track and reaction events.
+// Track events switch the current track, reaction events only refresh it.
+// The track is loaded outside the lock, so events are applied only if they
+// are newer than the one the current state comes from. Listener count
+// events update the count.
+func (b *Broadcaster) HandleEvent(ctx context.Context, event shared.DomainEvent) error {
+	if c, ok := event.(listener.ListenersCounted); ok {
+		b.updateListeners(ctx, c.Count(), c.OccurredAt())
+		return nil
+	}
+
+	e, ok := event.(interface{ TrackID() track.TrackID })
+	if !ok {
+		return nil
+	}
+	trackID := e.TrackID().String()
+
+	switching := event.EventName() == track.EventTrackCreated || event.EventName() == track.EventTrackRotated
+	if !switching && !b.isCurrent(ctx, trackID) {
+		return nil
+	}
+
+	// Handlers outlive the request that published the event
+	t, err := b.tracks.Handle(context.WithoutCancel(ctx), apptrack.GetTrackQuery{ID: trackID})
+	if err != nil {
+		b.logger.WithContext("nowplaying", "refresh").
+			WithError(err).
+			WithField("track_id", trackID).
+			Error("failed to load track")
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := b.channel(ctx)
+	at := event.OccurredAt()
+	if switching {
+		if !at.After(ch.switchedAt) {
+			return nil
+		}
+		ch.switchedAt = at
+	} else if ch.current.Track == nil || ch.current.Track.ID != trackID || at.Before(ch.refreshedAt) {
+		return nil
+	}
+	ch.refreshedAt = at
+	if !switching && sameCounts(ch.current.Track, t) {
+		return nil
+	}
+
+	next := ch.current
+	next.Track = t
+	ch.emit(next)
+	return nil
+}
+
+// updateListeners records a listener count of the station ctx is scoped
+// to, unless a later count was already applied.
+func (b *Broadcaster) updateListeners(ctx context.Context, count int, at time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := b.channel(ctx)
+	if at.Before(ch.countedAt) {
+		return
+	}
+	ch.countedAt = at
+	if ch.current.Listeners == count {
+		return
+	}
+
+	next := ch.current
+	next.Listeners = count
+	ch.emit(next)
+}
+
+// Subscribe registers a new subscriber to the station ctx is scoped to.
+// With the ID of the last frame a client saw, the frames it missed are
+// replayed; if they are no longer kept, or no ID is given, the latest frame is.
+func (b *Broadcaster) Subscribe(ctx context.Context, lastEventID string) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := b.channel(ctx)
+	sub := &Subscription{
+		Replay:      ch.replay(lastEventID),
+		frames:      make(chan Frame, subscriberBuffer),
+		channel:     ch,
+		broadcaster: b,
+	}
+	ch.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (ch *channel) replay(lastEventID string) []Frame {
+	if len(ch.history) == 0 {
+		return nil
+	}
+	latest := ch.history[len(ch.history)-1:]
+
+	last, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil || last > ch.seq || last+1 < ch.history[0].ID {
+		return latest
+	}
+
+	missed := make([]Frame, 0, ch.seq-last)
+	for _, f := range ch.history {
+		if f.ID > last {
+			missed = append(missed, f)
+		}
+	}
+	return missed
+}
+
+func (b *Broadcaster) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := sub.channel.subscribers[sub]; ok {
+		delete(sub.channel.subscribers, sub)
+		close(sub.frames)
+	}
+}
+
+// emit stores the new state and sends it to every subscriber of the
+// channel. Callers hold the broadcaster's mu.
+func (ch *channel) emit(next NowPlaying) {
+	next.UpdatedAt = time.Now()
+	ch.current = next
+	ch.seq++
+
+	frame := Frame{ID: ch.seq, NowPlaying: next}
+	ch.history = append(ch.history, frame)
+	if len(ch.history) > historySize {
+		ch.history = ch.history[len(ch.history)-historySize:]
+	}
+
+	for sub := range ch.subscribers {
+		select {
+		case sub.frames <- frame:
+		default:
+			// Too slow: drop it, the client resumes from its last event ID
+			delete(ch.subscribers, sub)
+			close(sub.frames)
+		}
+	}
+}
+
+func (b *Broadcaster) isCurrent(ctx context.Context, trackID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	current := b.channel(ctx).current
+	return current.Track != nil && current.Track.ID == trackID
+}
+
+func sameCounts(a, b *apptrack.TrackDTO) bool {
+	if a.Likes != b.Likes || a.Dislikes != b.Dislikes || len(a.Reactions) != len(b.Reactions) {
+		return false
+	}
+	for k, v := range a.Reactions {
+		if b.Reactions[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package play
+
+import "time"
+
+// RecordPlayCommand represents the command to record a new play of a track.
+type RecordPlayCommand struct {
+	TrackID string
+	// StartedAt is when the track went on air.
+	StartedAt time.Time
+}
+
+// GetHistoryQuery represents the query to list past plays.
+type GetHistoryQuery struct {
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Cursor string
+}
+
+// HistoryCriteria is the resolved filter passed to the history read model.
+type HistoryCriteria struct {
+	From  *time.Time
+	To    *time.Time
+	Limit int
+
+	// Keyset position of the last play on the previous page.
+	BeforeStartedAt *time.Time
+	BeforeID        string
+}
+
+// PlayDTO represents a play joined with its track for external use.
+type PlayDTO struct {
+	ID             string
+	TrackID        string
+	Title          string
+	Cover          string
+	StartedAt      time.Time
+	EndedAt        *time.Time
+	ListenersStart int
+	ListenersEnd   *int
+}
+
+// HistoryResult represents a page of play history.
+type HistoryResult struct {
+	Plays      []*PlayDTO
+	NextCursor string
+}
@@ -0,0 +1,87 @@
+package play
+
+import (
+	"context"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	domainplay "hub/internal/domain/play"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// HistoryReader defines the read model for play history.
+type HistoryReader interface {
+	FindHistory(ctx context.Context, criteria HistoryCriteria) ([]*PlayDTO, error)
+}
+
+// GetHistoryHandler handles the get history use case.
+type GetHistoryHandler struct {
+	reader HistoryReader
+}
+
+// NewGetHistoryHandler creates a new GetHistoryHandler.
+func NewGetHistoryHandler(reader HistoryReader) *GetHistoryHandler {
+	return &GetHistoryHandler{reader: reader}
+}
+
+// Handle executes the get history use case.
+// Plays are returned newest first.
+func (h *GetHistoryHandler) Handle(ctx context.Context, query GetHistoryQuery) (*HistoryResult, error) {
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, domainplay.ErrInvalidTimeRange
+	}
+
+	criteria := HistoryCriteria{
+		From:  query.From,
+		To:    query.To,
+		Limit: clampLimit(query.Limit),
+	}
+
+	if query.Cursor != "" {
+		values, err := appshared.DecodeCursor(query.Cursor, 2)
+		if err != nil {
+			return nil, err
+		}
+		startedAt, err := time.Parse(time.RFC3339Nano, values[0])
+		if err != nil {
+			return nil, appshared.ErrInvalidCursor
+		}
+		if _, err := uuid.Parse(values[1]); err != nil {
+			return nil, appshared.ErrInvalidCursor
+		}
+		criteria.BeforeStartedAt = &startedAt
+		criteria.BeforeID = values[1]
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	criteria.Limit++
+	plays, err := h.reader.FindHistory(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HistoryResult{Plays: plays}
+	if len(plays) == criteria.Limit {
+		result.Plays = plays[:len(plays)-1]
+		last := result.Plays[len(result.Plays)-1]
+		result.NextCursor = appshared.EncodeCursor(last.StartedAt.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return result, nil
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		return maxHistoryLimit
+	}
+	return limit
+}
@@ -0,0 +1,169 @@
+package play
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hub/internal/application/radio"
+	appshared "hub/internal/application/shared"
+	domainplay "hub/internal/domain/play"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/track"
+	"hub/internal/logger"
+)
+
+// boundaryGrace is how long after a track starts departures are still put
+// down to the previous track ending rather than to tuning out of this one.
+// It covers a listener poll or so.
+const boundaryGrace = 5 * time.Second
+
+// AudienceCounter counts listener sessions open at a time, or starting or
+// ending in a time range.
+type AudienceCounter interface {
+	// CountOpen counts the sessions open at a time.
+	CountOpen(ctx context.Context, at time.Time) (int, error)
+
+	// CountJoined counts the sessions started within [from, to).
+	CountJoined(ctx context.Context, from, to time.Time) (int, error)
+
+	// CountLeft counts the sessions open at openAt that ended within [from, to).
+	CountLeft(ctx context.Context, openAt, from, to time.Time) (int, error)
+}
+
+// RecordPlayHandler handles the record play use case.
+// It closes the play currently on air and opens a new one.
+type RecordPlayHandler struct {
+	repo          domainplay.Repository
+	uow           appshared.UnitOfWork
+	radioService  radio.Service
+	audience      AudienceCounter
+	tuneOutWindow time.Duration
+	logger        *logger.Logger
+}
+
+// NewRecordPlayHandler creates a new RecordPlayHandler. Listeners leaving
+// within tuneOutWindow of a play starting count as tuning out of it.
+func NewRecordPlayHandler(
+	repo domainplay.Repository,
+	uow appshared.UnitOfWork,
+	radioService radio.Service,
+	audience AudienceCounter,
+	tuneOutWindow time.Duration,
+	log *logger.Logger,
+) *RecordPlayHandler {
+	return &RecordPlayHandler{
+		repo:          repo,
+		uow:           uow,
+		radioService:  radioService,
+		audience:      audience,
+		tuneOutWindow: tuneOutWindow,
+		logger:        log,
+	}
+}
+
+// Handle executes the record play use case. The play on air is locked
+// while it is replaced, and a command no newer than it is ignored, so
+// redelivered and out-of-order events don't open extra plays.
+func (h *RecordPlayHandler) Handle(ctx context.Context, cmd RecordPlayCommand) error {
+	trackID, err := track.NewTrackID(cmd.TrackID)
+	if err != nil {
+		return err
+	}
+
+	listeners := h.currentListeners(ctx)
+	// Without listener lists there are no sessions to count the audience from
+	sessions := h.radioService.ListsListeners(ctx)
+
+	return appshared.WithinTransaction(ctx, h.uow, func(ctx context.Context) error {
+		current, err := h.repo.FindCurrentForUpdate(ctx)
+		if err != nil && !errors.Is(err, domainplay.ErrPlayNotFound) {
+			return err
+		}
+
+		if current != nil {
+			if !cmd.StartedAt.After(current.StartedAt()) {
+				h.logger.WithContext("play", "record").
+					WithField("track_id", cmd.TrackID).
+					Debug("play already recorded or superseded, skipping")
+				return nil
+			}
+			if err := current.End(listeners, cmd.StartedAt); err != nil {
+				return err
+			}
+			if sessions {
+				h.recordAudience(ctx, current)
+			}
+			if err := h.repo.Save(ctx, current); err != nil {
+				return err
+			}
+		}
+
+		return h.repo.Save(ctx, domainplay.NewPlay(trackID, listeners, cmd.StartedAt))
+	})
+}
+
+// HandleEvent records a play for track.created and track.rotated events.
+func (h *RecordPlayHandler) HandleEvent(ctx context.Context, event shared.DomainEvent) error {
+	e, ok := event.(interface{ TrackID() track.TrackID })
+	if !ok {
+		return nil
+	}
+
+	err := h.Handle(ctx, RecordPlayCommand{TrackID: e.TrackID().String(), StartedAt: event.OccurredAt()})
+	if err != nil {
+		h.logger.WithContext("play", "record").
+			WithError(err).
+			WithField("track_id", e.TrackID().String()).
+			Error("failed to record play")
+	}
+	return err
+}
+
+// currentListeners returns the current listener count, or 0 if the stream is unreachable.
+func (h *RecordPlayHandler) currentListeners(ctx context.Context) int {
+	info, err := h.radioService.GetListeners(ctx)
+	if err != nil {
+		h.logger.WithContext("play", "record").
+			WithError(err).
+			Warn("failed to get listener count, recording 0")
+		return 0
+	}
+	return info.Current
+}
+
+// recordAudience counts the listeners present when an ended play started,
+// those who joined during it and those present who left within the
+// tune-out window. Counting is best effort: the play is recorded without
+// its audience if the sessions can't be read.
+func (h *RecordPlayHandler) recordAudience(ctx context.Context, p *domainplay.Play) {
+	start, end := p.StartedAt(), *p.EndedAt()
+	log := h.logger.WithContext("play", "audience").WithField("play_id", p.ID())
+
+	present, err := h.audience.CountOpen(ctx, start)
+	if err != nil {
+		log.WithError(err).Warn("failed to count present listeners")
+		return
+	}
+
+	joined, err := h.audience.CountJoined(ctx, start, end)
+	if err != nil {
+		log.WithError(err).Warn("failed to count joined listeners")
+		return
+	}
+
+	tunedOut := 0
+	from, to := start.Add(boundaryGrace), start.Add(h.tuneOutWindow)
+	if to.After(end) {
+		to = end
+	}
+	if from.Before(to) {
+		tunedOut, err = h.audience.CountLeft(ctx, start, from, to)
+		if err != nil {
+			log.WithError(err).Warn("failed to count tuned out listeners")
+			return
+		}
+	}
+
+	_ = p.RecordAudience(present, joined, tunedOut)
+}
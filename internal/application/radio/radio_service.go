@@ -4,13 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
-	"hub/internal/infrastructure/icecast"
+	appshared "hub/internal/application/shared"
+	"hub/internal/infrastructure/streaming"
 )
 
+// listenersTTL is how long a station's listener count is reused. Merging
+// the listener lists of several mounts takes an authenticated request per
+// mount, which public endpoints must not make on every call.
+const listenersTTL = 5 * time.Second
+
 var (
 	ErrInvalidResponse = errors.New("invalid response from icecast server")
 	ErrNoActiveStream  = errors.New("no active stream available")
+	ErrMountNotFound   = errors.New("mount not found")
 )
 
 // RadioInfo represents radio stream information.
@@ -28,44 +37,229 @@ type ListenerInfo struct {
 	Peak    int
 }
 
+// MountInfo represents the stream information of a single mount.
+type MountInfo struct {
+	Mount        string
+	Live         bool
+	Name         string
+	Description  string
+	StreamUrl    string
+	Bitrate      string
+	Listeners    int
+	ListenerPeak int
+}
+
 // Service defines the radio service interface.
+// Radio info and listener counts cover every live mount of the channel
+// of the station the context is scoped to. Listener counts are reused for
+// a few seconds.
 type Service interface {
 	GetRadioInfo(ctx context.Context) (*RadioInfo, error)
 	GetListeners(ctx context.Context) (*ListenerInfo, error)
+	GetMounts(ctx context.Context) ([]*MountInfo, error)
+	GetMountInfo(ctx context.Context, mount string) (*RadioInfo, error)
+
+	// ListsListeners reports whether the server lists individual listeners,
+	// which listener sessions are tracked from, as configured and without
+	// asking the server. It is false for servers read through their public
+	// status and SHOUTcast servers without an admin password.
+	ListsListeners(ctx context.Context) bool
 }
 
 type service struct {
-	icecastClient icecast.Client
+	clients streaming.Clients
+
+	mu        sync.Mutex
+	listeners map[int64]countedListeners
+}
+
+// countedListeners is a cached listener count.
+type countedListeners struct {
+	info      ListenerInfo
+	expiresAt time.Time
 }
 
 // NewService creates a new radio service.
-func NewService(icecastClient icecast.Client) Service {
-	return &service{icecastClient: icecastClient}
+func NewService(clients streaming.Clients) Service {
+	return &service{clients: clients, listeners: make(map[int64]countedListeners)}
 }
 
 func (s *service) GetRadioInfo(ctx context.Context) (*RadioInfo, error) {
-	source, err := s.icecastClient.MountStats()
+	client, err := s.clients.Get(appshared.StationID(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get icecast stats: %w", err)
+		return nil, err
 	}
 
+	stats, err := liveStats(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	listeners, err := s.countListeners(ctx, client, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mounts carry the same channel, so the primary one describes it
+	primary := stats[0]
 	return &RadioInfo{
-		Name:         source.Name,
-		Description:  source.Description,
-		StreamUrl:    source.StreamURL,
-		Listeners:    source.Listeners,
-		ListenerPeak: source.ListenerPeak,
+		Name:         primary.Name,
+		Description:  primary.Description,
+		StreamUrl:    primary.StreamURL,
+		Listeners:    listeners.Current,
+		ListenerPeak: listeners.Peak,
 	}, nil
 }
 
 func (s *service) GetListeners(ctx context.Context) (*ListenerInfo, error) {
-	source, err := s.icecastClient.MountStats()
+	client, err := s.clients.Get(appshared.StationID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := liveStats(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.countListeners(ctx, client, stats)
+}
+
+func (s *service) GetMounts(ctx context.Context) ([]*MountInfo, error) {
+	client, err := s.clients.Get(appshared.StationID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := client.MountStats(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get icecast stats: %w", err)
 	}
 
-	return &ListenerInfo{
-		Current: source.Listeners,
-		Peak:    source.ListenerPeak,
-	}, nil
+	live := make(map[string]*streaming.SourceStats, len(stats))
+	for _, source := range stats {
+		live[source.Mount] = source
+	}
+
+	mounts := make([]*MountInfo, 0, len(client.Mounts()))
+	for _, mount := range client.Mounts() {
+		info := &MountInfo{Mount: mount}
+		if source, ok := live[mount]; ok {
+			info.Live = true
+			info.Name = source.Name
+			info.Description = source.Description
+			info.StreamUrl = source.StreamURL
+			info.Bitrate = source.Bitrate
+			info.Listeners = source.Listeners
+			info.ListenerPeak = source.ListenerPeak
+		}
+		mounts = append(mounts, info)
+	}
+	return mounts, nil
+}
+
+func (s *service) GetMountInfo(ctx context.Context, mount string) (*RadioInfo, error) {
+	mounts, err := s.GetMounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range mounts {
+		if m.Mount != mount {
+			continue
+		}
+		if !m.Live {
+			return nil, ErrNoActiveStream
+		}
+		return &RadioInfo{
+			Name:         m.Name,
+			Description:  m.Description,
+			StreamUrl:    m.StreamUrl,
+			Listeners:    m.Listeners,
+			ListenerPeak: m.ListenerPeak,
+		}, nil
+	}
+	return nil, ErrMountNotFound
+}
+
+func (s *service) ListsListeners(ctx context.Context) bool {
+	client, err := s.clients.Get(appshared.StationID(ctx))
+	if err != nil {
+		return false
+	}
+	return client.ListsListeners()
+}
+
+// liveStats returns the stats of the live mounts, primary first.
+func liveStats(ctx context.Context, client streaming.Client) ([]*streaming.SourceStats, error) {
+	stats, err := client.MountStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get icecast stats: %w", err)
+	}
+	if len(stats) == 0 {
+		return nil, ErrNoActiveStream
+	}
+	return stats, nil
+}
+
+// countListeners returns the listener count of the station ctx is scoped
+// to, counted at most once per listenersTTL.
+func (s *service) countListeners(ctx context.Context, client streaming.Client, stats []*streaming.SourceStats) (*ListenerInfo, error) {
+	stationID := appshared.StationID(ctx)
+
+	s.mu.Lock()
+	cached, ok := s.listeners[stationID]
+	s.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		info := cached.info
+		return &info, nil
+	}
+
+	info, err := listeners(ctx, client, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.listeners[stationID] = countedListeners{info: *info, expiresAt: time.Now().Add(listenersTTL)}
+	s.mu.Unlock()
+	return info, nil
+}
+
+// listeners counts the listeners of the live mounts, each listener once.
+// The peak is the highest mount peak, as servers keep no combined one.
+// Without a listener list, listeners on several mounts count once per mount.
+func listeners(ctx context.Context, client streaming.Client, stats []*streaming.SourceStats) (*ListenerInfo, error) {
+	info := &ListenerInfo{}
+	total := 0
+	for _, source := range stats {
+		info.Peak = max(info.Peak, source.ListenerPeak)
+		total += source.Listeners
+	}
+
+	if len(stats) == 1 {
+		info.Current = stats[0].Listeners
+		return info, nil
+	}
+
+	// A mount whose list can't be read counts its listeners once
+	lists := make([]*streaming.ClientList, 0, len(stats))
+	unlisted := 0
+	for _, source := range stats {
+		list, err := client.ListClients(ctx, source.Mount)
+		if errors.Is(err, streaming.ErrListenersUnavailable) {
+			info.Current = total
+			info.Peak = max(info.Peak, info.Current)
+			return info, nil
+		}
+		if err != nil {
+			unlisted += source.Listeners
+			continue
+		}
+		lists = append(lists, list)
+	}
+
+	info.Current = streaming.MergeClientLists(lists...).Count + unlisted
+	info.Peak = max(info.Peak, info.Current)
+	return info, nil
 }
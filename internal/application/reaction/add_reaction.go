@@ -11,19 +11,25 @@ import (
 // AddReactionHandler handles the add reaction use case.
 type AddReactionHandler struct {
 	reactionRepo domainreaction.Repository
+	typeRepo     domainreaction.TypeRepository
 	trackRepo    track.Repository
+	uow          appshared.UnitOfWork
 	publisher    appshared.EventPublisher
 }
 
 // NewAddReactionHandler creates a new AddReactionHandler.
 func NewAddReactionHandler(
 	reactionRepo domainreaction.Repository,
+	typeRepo domainreaction.TypeRepository,
 	trackRepo track.Repository,
+	uow appshared.UnitOfWork,
 	publisher appshared.EventPublisher,
 ) *AddReactionHandler {
 	return &AddReactionHandler{
 		reactionRepo: reactionRepo,
+		typeRepo:     typeRepo,
 		trackRepo:    trackRepo,
+		uow:          uow,
 		publisher:    publisher,
 	}
 }
@@ -41,7 +47,7 @@ func (h *AddReactionHandler) Handle(ctx context.Context, cmd AddReactionCommand)
 		return err
 	}
 
-	reactionType, err := domainreaction.NewReactionType(cmd.Reaction)
+	reactionType, err := resolveReactionType(ctx, h.typeRepo, cmd.Reaction)
 	if err != nil {
 		return err
 	}
@@ -58,18 +64,16 @@ func (h *AddReactionHandler) Handle(ctx context.Context, cmd AddReactionCommand)
 	// Create reaction
 	reaction := domainreaction.NewReaction(userID, trackID, reactionType)
 
-	// Save reaction - handles duplicate check atomically via database constraint
-	if err := h.reactionRepo.Save(ctx, reaction); err != nil {
-		return err
-	}
-
-	// Publish event
-	if h.publisher != nil {
-		event := domainreaction.NewReactionAdded(userID, trackID, reactionType)
-		if err := h.publisher.Publish(ctx, event); err != nil {
-			// Log error but don't fail the operation
+	return appshared.WithinTransaction(ctx, h.uow, func(ctx context.Context) error {
+		// Save reaction - handles duplicate check atomically via database constraint
+		if err := h.reactionRepo.Save(ctx, reaction); err != nil {
+			return err
 		}
-	}
 
-	return nil
+		// Record the event in the same transaction
+		if h.publisher != nil {
+			return h.publisher.Publish(ctx, domainreaction.NewReactionAdded(userID, trackID, reactionType))
+		}
+		return nil
+	})
 }
@@ -0,0 +1,77 @@
+package reaction
+
+import (
+	"context"
+
+	appshared "hub/internal/application/shared"
+	domainreaction "hub/internal/domain/reaction"
+	"hub/internal/domain/track"
+)
+
+// ChangeReactionHandler handles the change reaction use case.
+type ChangeReactionHandler struct {
+	reactionRepo domainreaction.Repository
+	typeRepo     domainreaction.TypeRepository
+	uow          appshared.UnitOfWork
+	publisher    appshared.EventPublisher
+}
+
+// NewChangeReactionHandler creates a new ChangeReactionHandler.
+func NewChangeReactionHandler(
+	reactionRepo domainreaction.Repository,
+	typeRepo domainreaction.TypeRepository,
+	uow appshared.UnitOfWork,
+	publisher appshared.EventPublisher,
+) *ChangeReactionHandler {
+	return &ChangeReactionHandler{
+		reactionRepo: reactionRepo,
+		typeRepo:     typeRepo,
+		uow:          uow,
+		publisher:    publisher,
+	}
+}
+
+// Handle executes the change reaction use case.
+// Switching to the reaction the user already has is a no-op.
+func (h *ChangeReactionHandler) Handle(ctx context.Context, cmd ChangeReactionCommand) error {
+	userID, err := domainreaction.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	trackID, err := track.NewTrackID(cmd.TrackID)
+	if err != nil {
+		return err
+	}
+
+	reactionType, err := resolveReactionType(ctx, h.typeRepo, cmd.Reaction)
+	if err != nil {
+		return err
+	}
+
+	reaction, err := h.reactionRepo.FindByUserAndTrack(ctx, userID, trackID)
+	if err != nil {
+		return err
+	}
+	if reaction == nil {
+		return domainreaction.ErrReactionNotFound
+	}
+
+	previous := reaction.ReactionType()
+	if !reaction.ChangeType(reactionType) {
+		return nil
+	}
+
+	return appshared.WithinTransaction(ctx, h.uow, func(ctx context.Context) error {
+		// Update reaction and track counters
+		if err := h.reactionRepo.Update(ctx, reaction, previous); err != nil {
+			return err
+		}
+
+		// Record the event in the same transaction
+		if h.publisher != nil {
+			return h.publisher.Publish(ctx, domainreaction.NewReactionChanged(userID, trackID, previous, reactionType))
+		}
+		return nil
+	})
+}
@@ -4,7 +4,7 @@ package reaction
 type AddReactionCommand struct {
 	UserID   string
 	TrackID  string
-	Reaction string // "like" or "dislike"
+	Reaction string // a configured reaction type key, e.g. "like"
 }
 
 // CheckReactionQuery represents the query to check a reaction.
@@ -18,3 +18,23 @@ type CheckReactionResult struct {
 	HasReacted bool
 	Reaction   string
 }
+
+// ChangeReactionCommand represents the command to switch an existing reaction.
+type ChangeReactionCommand struct {
+	UserID   string
+	TrackID  string
+	Reaction string // a configured reaction type key, e.g. "like"
+}
+
+// RemoveReactionCommand represents the command to retract a reaction.
+type RemoveReactionCommand struct {
+	UserID  string
+	TrackID string
+}
+
+// ReactionTypeDTO represents a configured reaction type.
+type ReactionTypeDTO struct {
+	Key   string
+	Emoji string
+	Label string
+}
@@ -0,0 +1,57 @@
+package reaction
+
+import (
+	"context"
+
+	domainreaction "hub/internal/domain/reaction"
+)
+
+// ListReactionTypesHandler handles the list reaction types use case.
+type ListReactionTypesHandler struct {
+	typeRepo domainreaction.TypeRepository
+}
+
+// NewListReactionTypesHandler creates a new ListReactionTypesHandler.
+func NewListReactionTypesHandler(typeRepo domainreaction.TypeRepository) *ListReactionTypesHandler {
+	return &ListReactionTypesHandler{typeRepo: typeRepo}
+}
+
+// Handle returns the enabled reaction types in display order.
+func (h *ListReactionTypesHandler) Handle(ctx context.Context) ([]*ReactionTypeDTO, error) {
+	definitions, err := h.typeRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]*ReactionTypeDTO, 0, len(definitions))
+	for _, def := range definitions {
+		if !def.Enabled() {
+			continue
+		}
+		types = append(types, &ReactionTypeDTO{
+			Key:   def.ReactionType().String(),
+			Emoji: def.Emoji(),
+			Label: def.Label(),
+		})
+	}
+
+	return types, nil
+}
+
+// resolveReactionType parses a reaction type and checks that it is configured and enabled.
+func resolveReactionType(ctx context.Context, typeRepo domainreaction.TypeRepository, value string) (domainreaction.ReactionType, error) {
+	reactionType, err := domainreaction.NewReactionType(value)
+	if err != nil {
+		return domainreaction.ReactionType{}, err
+	}
+
+	def, err := typeRepo.FindByType(ctx, reactionType)
+	if err != nil {
+		return domainreaction.ReactionType{}, err
+	}
+	if !def.Enabled() {
+		return domainreaction.ReactionType{}, domainreaction.ErrInvalidReactionType
+	}
+
+	return reactionType, nil
+}
@@ -0,0 +1,63 @@
+package reaction
+
+import (
+	"context"
+
+	appshared "hub/internal/application/shared"
+	domainreaction "hub/internal/domain/reaction"
+	"hub/internal/domain/track"
+)
+
+// RemoveReactionHandler handles the remove reaction use case.
+type RemoveReactionHandler struct {
+	reactionRepo domainreaction.Repository
+	uow          appshared.UnitOfWork
+	publisher    appshared.EventPublisher
+}
+
+// NewRemoveReactionHandler creates a new RemoveReactionHandler.
+func NewRemoveReactionHandler(
+	reactionRepo domainreaction.Repository,
+	uow appshared.UnitOfWork,
+	publisher appshared.EventPublisher,
+) *RemoveReactionHandler {
+	return &RemoveReactionHandler{
+		reactionRepo: reactionRepo,
+		uow:          uow,
+		publisher:    publisher,
+	}
+}
+
+// Handle executes the remove reaction use case.
+func (h *RemoveReactionHandler) Handle(ctx context.Context, cmd RemoveReactionCommand) error {
+	userID, err := domainreaction.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	trackID, err := track.NewTrackID(cmd.TrackID)
+	if err != nil {
+		return err
+	}
+
+	reaction, err := h.reactionRepo.FindByUserAndTrack(ctx, userID, trackID)
+	if err != nil {
+		return err
+	}
+	if reaction == nil {
+		return domainreaction.ErrReactionNotFound
+	}
+
+	return appshared.WithinTransaction(ctx, h.uow, func(ctx context.Context) error {
+		// Delete reaction and decrement the track counter
+		if err := h.reactionRepo.Delete(ctx, reaction); err != nil {
+			return err
+		}
+
+		// Record the event in the same transaction
+		if h.publisher != nil {
+			return h.publisher.Publish(ctx, domainreaction.NewReactionRemoved(userID, trackID, reaction.ReactionType()))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,22 @@
+package shared
+
+import "context"
+
+// CorrelationIDKey is the context key carrying the correlation ID of the
+// request or event being handled. It is a plain string so the ID set by the
+// HTTP middleware on the request locals is visible through the request context.
+const CorrelationIDKey = "correlation_id"
+
+// WithCorrelationID returns a context carrying the correlation ID.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, CorrelationIDKey, id)
+}
+
+// CorrelationID returns the correlation ID carried by ctx, or "" if none.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(CorrelationIDKey).(string)
+	return id
+}
@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"hub/internal/domain/shared"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidCursor = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"invalid pagination cursor",
+)
+
+const cursorSeparator = "|"
+
+// EncodeCursor packs keyset pagination values into an opaque cursor.
+func EncodeCursor(values ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(values, cursorSeparator)))
+}
+
+// DecodeCursor unpacks an opaque cursor into exactly n keyset values.
+// The last value may contain the separator.
+func DecodeCursor(cursor string, n int) ([]string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	values := strings.SplitN(string(raw), cursorSeparator, n)
+	if len(values) != n {
+		return nil, ErrInvalidCursor
+	}
+	return values, nil
+}
@@ -0,0 +1,22 @@
+package shared
+
+import "context"
+
+// eventIDKey is the context key carrying the ID of the event being handled.
+type eventIDKey struct{}
+
+// WithEventID returns a context carrying the ID of the event being handled.
+// Redeliveries of an event carry the same ID, so handlers can use it to
+// drop duplicates.
+func WithEventID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, eventIDKey{}, id)
+}
+
+// EventID returns the event ID carried by ctx, or "" if none.
+func EventID(ctx context.Context) string {
+	id, _ := ctx.Value(eventIDKey{}).(string)
+	return id
+}
@@ -14,3 +14,11 @@ type EventPublisher interface {
 	// PublishAll publishes multiple domain events.
 	PublishAll(ctx context.Context, events []shared.DomainEvent) error
 }
+
+// BroadcastPublisher publishes events straight to the broadcast handlers of
+// every replica, bypassing the outbox. It suits frequent state that only
+// matters to connected clients, such as listener counts: a lost event is
+// superseded by the next one.
+type BroadcastPublisher interface {
+	EventPublisher
+}
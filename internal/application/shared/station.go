@@ -0,0 +1,30 @@
+package shared
+
+import "context"
+
+// StationIDKey is the context key carrying the ID of the station a request
+// or event belongs to. Like CorrelationIDKey it is a plain string, so the
+// station resolved by the HTTP middleware is visible through the request context.
+const StationIDKey = "station_id"
+
+// WithStationID returns a context scoped to the station.
+func WithStationID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, StationIDKey, id)
+}
+
+// StationID returns the station ctx is scoped to. Every request, event and
+// job picks its station, so an unscoped context is a bug: StationID panics
+// rather than mix the data of stations.
+func StationID(ctx context.Context) int64 {
+	id, ok := LookupStationID(ctx)
+	if !ok {
+		panic("context is not scoped to a station")
+	}
+	return id
+}
+
+// LookupStationID returns the station ctx is scoped to, if any.
+func LookupStationID(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(StationIDKey).(int64)
+	return id, ok && id != 0
+}
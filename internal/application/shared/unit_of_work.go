@@ -14,6 +14,23 @@ type UnitOfWork interface {
 	Rollback(ctx context.Context) error
 }
 
+// WithinTransaction runs fn in a transaction, committing when it succeeds and
+// rolling back when it fails. Repositories and the event publisher pick the
+// transaction up from the context passed to fn.
+func WithinTransaction(ctx context.Context, uow UnitOfWork, fn func(ctx context.Context) error) error {
+	txCtx, err := uow.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(txCtx); err != nil {
+		_ = uow.Rollback(txCtx)
+		return err
+	}
+
+	return uow.Commit(txCtx)
+}
+
 // txKey is the context key for storing transactions.
 type txKey struct{}
 
@@ -0,0 +1,79 @@
+package station
+
+import (
+	"context"
+	"fmt"
+
+	"hub/internal/domain/station"
+)
+
+// Definition describes a configured station.
+type Definition struct {
+	Slug string
+	Name string
+}
+
+// Registry holds the configured stations. They are saved once at startup and
+// looked up in memory afterwards; stations since removed from the
+// configuration keep their data but are no longer served.
+type Registry struct {
+	stations []*station.Station
+	bySlug   map[string]*station.Station
+	byID     map[int64]*station.Station
+}
+
+// NewRegistry saves the configured stations, in order, and registers them.
+func NewRegistry(ctx context.Context, repo station.Repository, defs []Definition) (*Registry, error) {
+	r := &Registry{
+		bySlug: make(map[string]*station.Station, len(defs)),
+		byID:   make(map[int64]*station.Station, len(defs)),
+	}
+
+	for _, def := range defs {
+		s, err := station.NewStation(def.Slug, def.Name)
+		if err != nil {
+			return nil, fmt.Errorf("station %q: %w", def.Slug, err)
+		}
+		if _, ok := r.bySlug[s.Slug()]; ok {
+			return nil, fmt.Errorf("station %q configured twice", s.Slug())
+		}
+		s, err = repo.Save(ctx, s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save station %q: %w", s.Slug(), err)
+		}
+
+		r.stations = append(r.stations, s)
+		r.bySlug[s.Slug()] = s
+		r.byID[s.ID()] = s
+	}
+
+	if _, ok := r.byID[station.DefaultID]; !ok {
+		return nil, fmt.Errorf("default station %q is not configured", station.DefaultSlug)
+	}
+	return r, nil
+}
+
+// All returns the stations in configured order.
+func (r *Registry) All() []*station.Station {
+	return r.stations
+}
+
+// Get returns the station with the given slug.
+// Returns ErrStationNotFound if no such station is configured.
+func (r *Registry) Get(slug string) (*station.Station, error) {
+	s, ok := r.bySlug[slug]
+	if !ok {
+		return nil, station.ErrStationNotFound
+	}
+	return s, nil
+}
+
+// ByID returns the station with the given ID.
+// Returns ErrStationNotFound if no such station is configured.
+func (r *Registry) ByID(id int64) (*station.Station, error) {
+	s, ok := r.byID[id]
+	if !ok {
+		return nil, station.ErrStationNotFound
+	}
+	return s, nil
+}
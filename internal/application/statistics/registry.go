@@ -0,0 +1,76 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+)
+
+// QueryFunc is the strategy a category uses to load its tracks.
+// Repository method expressions such as Repository.GetTopLikes satisfy it.
+type QueryFunc func(repo Repository, ctx context.Context, page Page) ([]*TrackStats, error)
+
+// Definition describes a statistics category.
+type Definition struct {
+	Key          string
+	Description  string
+	Icon         string
+	DefaultLimit int
+	Query        QueryFunc
+}
+
+// Registry holds the statistics categories in display order.
+type Registry struct {
+	definitions []*Definition
+	byKey       map[string]*Definition
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{byKey: make(map[string]*Definition)}
+}
+
+// Register adds a category. Keys must be unique.
+func (r *Registry) Register(def *Definition) error {
+	if def.Key == "" || def.Query == nil {
+		return fmt.Errorf("statistics category must have a key and a query")
+	}
+	if _, ok := r.byKey[def.Key]; ok {
+		return fmt.Errorf("statistics category %q already registered", def.Key)
+	}
+
+	r.definitions = append(r.definitions, def)
+	r.byKey[def.Key] = def
+	return nil
+}
+
+// Get returns the category with the given key.
+func (r *Registry) Get(key string) (*Definition, bool) {
+	def, ok := r.byKey[key]
+	return def, ok
+}
+
+// All returns every category in registration order.
+func (r *Registry) All() []*Definition {
+	return r.definitions
+}
+
+// NewDefaultRegistry creates the registry of built-in categories,
+// each returning defaultLimit tracks unless a limit is requested.
+func NewDefaultRegistry(defaultLimit int) *Registry {
+	r := NewRegistry()
+	for _, def := range []*Definition{
+		{Key: "history", Description: "Recently played tracks", Icon: "HistoryIcon", Query: Repository.GetHistory},
+		{Key: "listen", Description: "Most listened tracks", Icon: "ListenIcon", Query: Repository.GetTopListened},
+		{Key: "rotate", Description: "Most rotated tracks", Icon: "RotateIcon", Query: Repository.GetTopRotate},
+		{Key: "likes", Description: "Most liked tracks", Icon: "LikeIcon", Query: Repository.GetTopLikes},
+		{Key: "dislikes", Description: "Most disliked tracks", Icon: "DislikeIcon", Query: Repository.GetTopDislikes},
+		{Key: "artists", Description: "Top artists", Icon: "ArtistIcon", Query: Repository.GetTopArtists},
+		{Key: "tune-ins", Description: "Tracks listeners tune in to", Icon: "TuneInIcon", Query: Repository.GetTopTuneIns},
+		{Key: "tune-outs", Description: "Tracks listeners tune out on", Icon: "TuneOutIcon", Query: Repository.GetTopTuneOuts},
+		{Key: "tune-out-rate", Description: "Tracks with the highest tune-out rate", Icon: "SkipIcon", Query: Repository.GetTopTuneOutRate},
+	} {
+		def.DefaultLimit = defaultLimit
+		_ = r.Register(def)
+	}
+	return r
+}
@@ -2,16 +2,34 @@ package statistics
 
 import (
 	"context"
+	"time"
+
+	"hub/internal/domain/shared"
+)
+
+const maxPageLimit = 100
+
+// ErrCategoryNotFound is returned when a statistics category key is unknown.
+var ErrCategoryNotFound = shared.NewDomainError(
+	shared.ErrNotFound,
+	"statistics category not found",
 )
 
 // TrackStats represents track statistics.
+// Rotate, Likes, Dislikes and Listeners are lifetime counters;
+// Count is the category metric within the requested window.
+// Artist categories aggregate per artist and set Slug.
 type TrackStats struct {
 	Title     string
+	Artist    string
+	Slug      string
+	Song      string
 	Cover     string
 	Rotate    int
 	Likes     int
 	Dislikes  int
 	Listeners int
+	Count     int
 }
 
 // Category represents a statistics category.
@@ -20,62 +38,131 @@ type Category struct {
 	Description string
 	Icon        string
 	Tracks      []*TrackStats
+	Limit       int
+	Offset      int
+	HasMore     bool
+}
+
+// Listening summarizes listener sessions started within a window.
+// Sessions counts every session, AverageSession only those that ended.
+type Listening struct {
+	Sessions       int
+	AverageSession time.Duration
+	Hours          float64
+	Days           []*ListeningDay
+}
+
+// ListeningDay is the listening time spent on one calendar day, with
+// sessions spanning midnight split between the days.
+type ListeningDay struct {
+	Date     time.Time
+	Sessions int
+	Hours    float64
+}
+
+// Page selects the window and the slice of a category to load.
+type Page struct {
+	Window Window
+	Limit  int
+	Offset int
 }
 
 // Repository defines the statistics repository interface.
 type Repository interface {
-	GetHistory(ctx context.Context) ([]*TrackStats, error)
-	GetTopListened(ctx context.Context) ([]*TrackStats, error)
-	GetTopRotate(ctx context.Context) ([]*TrackStats, error)
-	GetTopLikes(ctx context.Context) ([]*TrackStats, error)
-	GetTopDislikes(ctx context.Context) ([]*TrackStats, error)
+	GetHistory(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopListened(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopRotate(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopLikes(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopDislikes(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopArtists(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopTuneIns(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopTuneOuts(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetTopTuneOutRate(ctx context.Context, page Page) ([]*TrackStats, error)
+	GetListening(ctx context.Context, window Window) (*Listening, error)
 }
 
 // Service defines the statistics service interface.
 type Service interface {
-	GetStatistics(ctx context.Context) ([]*Category, error)
+	// GetStatistics returns the first page of every registered category.
+	GetStatistics(ctx context.Context, window Window) ([]*Category, error)
+
+	// GetCategory returns one page of a single category.
+	// Returns ErrCategoryNotFound if the key is not registered.
+	GetCategory(ctx context.Context, key string, page Page) (*Category, error)
+
+	// GetListening summarizes listener sessions within the window.
+	GetListening(ctx context.Context, window Window) (*Listening, error)
 }
 
 type service struct {
-	repo Repository
+	repo     Repository
+	registry *Registry
 }
 
 // NewService creates a new statistics service.
-func NewService(repo Repository) Service {
-	return &service{repo: repo}
+func NewService(repo Repository, registry *Registry) Service {
+	return &service{repo: repo, registry: registry}
 }
 
-func (s *service) GetStatistics(ctx context.Context) ([]*Category, error) {
-	history, err := s.repo.GetHistory(ctx)
-	if err != nil {
-		return nil, err
+func (s *service) GetStatistics(ctx context.Context, window Window) ([]*Category, error) {
+	defs := s.registry.All()
+	categories := make([]*Category, 0, len(defs))
+
+	for _, def := range defs {
+		category, err := s.load(ctx, def, Page{Window: window})
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
 	}
 
-	topListened, err := s.repo.GetTopListened(ctx)
-	if err != nil {
-		return nil, err
+	return categories, nil
+}
+
+func (s *service) GetCategory(ctx context.Context, key string, page Page) (*Category, error) {
+	def, ok := s.registry.Get(key)
+	if !ok {
+		return nil, ErrCategoryNotFound
 	}
+	return s.load(ctx, def, page)
+}
 
-	topRotate, err := s.repo.GetTopRotate(ctx)
-	if err != nil {
-		return nil, err
+func (s *service) GetListening(ctx context.Context, window Window) (*Listening, error) {
+	return s.repo.GetListening(ctx, window)
+}
+
+// load runs the category query, fetching one extra row to detect further pages.
+func (s *service) load(ctx context.Context, def *Definition, page Page) (*Category, error) {
+	if page.Limit <= 0 {
+		page.Limit = def.DefaultLimit
+	}
+	if page.Limit > maxPageLimit {
+		page.Limit = maxPageLimit
+	}
+	if page.Offset < 0 {
+		page.Offset = 0
 	}
 
-	topLikes, err := s.repo.GetTopLikes(ctx)
+	limit := page.Limit
+	page.Limit++
+
+	tracks, err := def.Query(s.repo, ctx, page)
 	if err != nil {
 		return nil, err
 	}
 
-	topDislikes, err := s.repo.GetTopDislikes(ctx)
-	if err != nil {
-		return nil, err
+	hasMore := len(tracks) > limit
+	if hasMore {
+		tracks = tracks[:limit]
 	}
 
-	return []*Category{
-		{Description: "Last V tracks", Key: "history", Icon: "HistoryIcon", Tracks: history},
-		{Description: "Top V listend tracks", Key: "listen", Icon: "ListenIcon", Tracks: topListened},
-		{Description: "Top V rotated tracks", Key: "rotate", Icon: "RotateIcon", Tracks: topRotate},
-		{Description: "Top V liked tracks", Key: "likes", Icon: "LikeIcon", Tracks: topLikes},
-		{Description: "Top V disliked tracks", Key: "dislikes", Icon: "DislikeIcon", Tracks: topDislikes},
+	return &Category{
+		Key:         def.Key,
+		Description: def.Description,
+		Icon:        def.Icon,
+		Tracks:      tracks,
+		Limit:       limit,
+		Offset:      page.Offset,
+		HasMore:     hasMore,
 	}, nil
 }
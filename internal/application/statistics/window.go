@@ -0,0 +1,61 @@
+package statistics
+
+import (
+	"time"
+
+	"hub/internal/domain/shared"
+)
+
+// Named statistics periods accepted by ResolveWindow.
+const (
+	PeriodToday = "today"
+	PeriodWeek  = "7d"
+	PeriodMonth = "30d"
+	PeriodAll   = "all"
+)
+
+// ErrInvalidPeriod is returned when a period or custom range is invalid.
+var ErrInvalidPeriod = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"period must be one of today, 7d, 30d, all or a from/to range",
+)
+
+// Window bounds the source rows statistics are computed from.
+// A nil bound is open-ended; both nil means all time.
+type Window struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// IsAllTime returns true if the window has no bounds.
+func (w Window) IsAllTime() bool {
+	return w.From == nil && w.To == nil
+}
+
+// ResolveWindow turns a named period or a custom from/to range into a Window.
+// A custom range takes precedence over the period name.
+func ResolveWindow(period string, from, to *time.Time, now time.Time) (Window, error) {
+	if from != nil || to != nil {
+		if from != nil && to != nil && !from.Before(*to) {
+			return Window{}, ErrInvalidPeriod
+		}
+		return Window{From: from, To: to}, nil
+	}
+
+	var start time.Time
+	switch period {
+	case "", PeriodAll:
+		return Window{}, nil
+	case PeriodToday:
+		y, m, d := now.Date()
+		start = time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	case PeriodWeek:
+		start = now.AddDate(0, 0, -7)
+	case PeriodMonth:
+		start = now.AddDate(0, 0, -30)
+	default:
+		return Window{}, ErrInvalidPeriod
+	}
+
+	return Window{From: &start}, nil
+}
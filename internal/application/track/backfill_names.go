@@ -0,0 +1,82 @@
+package track
+
+import (
+	"context"
+
+	"hub/internal/domain/track"
+)
+
+const defaultBackfillBatchSize = 500
+
+// BackfillNamesHandler handles the backfill names use case.
+// It parses artist and song from the stored titles of existing tracks and
+// re-derives the artist slugs the SQL migration couldn't, such as those of
+// non-ASCII names.
+type BackfillNamesHandler struct {
+	repo   track.Repository
+	parser *track.TitleParser
+}
+
+// NewBackfillNamesHandler creates a new BackfillNamesHandler.
+func NewBackfillNamesHandler(repo track.Repository, parser *track.TitleParser) *BackfillNamesHandler {
+	return &BackfillNamesHandler{
+		repo:   repo,
+		parser: parser,
+	}
+}
+
+// Handle executes the backfill names use case, walking the catalogue oldest first.
+func (h *BackfillNamesHandler) Handle(ctx context.Context, cmd BackfillNamesCommand) (*BackfillNamesResult, error) {
+	batchSize := cmd.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBackfillBatchSize
+	}
+
+	criteria := track.Criteria{
+		SortBy: track.SortByCreatedAt,
+		Limit:  batchSize,
+	}
+	result := &BackfillNamesResult{}
+
+	for {
+		tracks, err := h.repo.FindByCriteria(ctx, criteria)
+		if err != nil {
+			return result, err
+		}
+
+		for _, t := range tracks {
+			result.Scanned++
+
+			if !cmd.Overwrite && !t.Name().IsEmpty() {
+				changed, err := h.repo.RefreshArtistSlug(ctx, t.ID(), t.Name())
+				if err != nil {
+					return result, err
+				}
+				if changed {
+					result.Updated++
+				}
+				continue
+			}
+
+			name := h.parser.Parse(t.Title().String())
+			if name.Equals(t.Name()) {
+				continue
+			}
+
+			if err := h.repo.UpdateName(ctx, t.ID(), name); err != nil {
+				return result, err
+			}
+			result.Updated++
+		}
+
+		if len(tracks) < batchSize {
+			return result, nil
+		}
+
+		last := tracks[len(tracks)-1]
+		criteria.After = &track.Position{
+			Value: sortValue(last, track.SortByCreatedAt),
+			ID:    last.ID(),
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package track
 
+import (
+	"time"
+
+	"hub/internal/domain/track"
+)
+
 // UpsertTrackCommand represents the command to create or update a track.
 type UpsertTrackCommand struct {
 	ID    string
@@ -12,18 +18,72 @@ type UpsertTrackResult struct {
 	Rotate int
 }
 
+// BackfillNamesCommand represents the command to parse artist and song for stored tracks.
+type BackfillNamesCommand struct {
+	BatchSize int
+	Overwrite bool // re-parse tracks that already have a name
+}
+
+// BackfillNamesResult represents the outcome of a backfill run.
+type BackfillNamesResult struct {
+	Scanned int
+	Updated int
+}
+
 // GetTrackQuery represents the query to get a track.
 type GetTrackQuery struct {
 	ID string
 }
 
+// ListTracksQuery represents the query to list and search the track catalogue.
+type ListTracksQuery struct {
+	Search string
+	Artist string // artist slug
+	Sort   string // rotate, likes, dislikes, listeners, created_at or updated_at
+	Order  string // "asc" or "desc"
+	Limit  int
+	Cursor string
+}
+
+// TrackListResult represents a page of the track catalogue.
+type TrackListResult struct {
+	Tracks     []*TrackDTO
+	NextCursor string
+}
+
 // TrackDTO represents a track for external use.
 type TrackDTO struct {
 	ID        string
 	Title     string
+	Artist    string
+	Song      string
+	Featuring []string
 	Cover     string
 	Rotate    int
 	Likes     int
 	Dislikes  int
 	Listeners int
+	Reactions map[string]int // per reaction type; only set for single-track lookups
+	// Share of listeners tuning out early in a play; only set for single-track lookups
+	TuneOutRate *float64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// newTrackDTO maps a Track aggregate to a TrackDTO.
+func newTrackDTO(t *track.Track) *TrackDTO {
+	return &TrackDTO{
+		ID:        t.ID().String(),
+		Title:     t.Title().String(),
+		Artist:    t.Name().Artist(),
+		Song:      t.Name().Song(),
+		Featuring: t.Name().Featuring(),
+		Cover:     t.Cover().String(),
+		Rotate:    t.Rotate(),
+		Likes:     t.Likes(),
+		Dislikes:  t.Dislikes(),
+		Listeners: t.Listeners(),
+		CreatedAt: t.CreatedAt(),
+		UpdatedAt: t.UpdatedAt(),
+	}
 }
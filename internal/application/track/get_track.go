@@ -6,14 +6,27 @@ import (
 	"hub/internal/domain/track"
 )
 
+// ReactionCounter reads the per-type reaction counters of a track.
+type ReactionCounter interface {
+	CountByTrack(ctx context.Context, id track.TrackID) (map[string]int, error)
+}
+
+// TuneOutReader reads the share of listeners who tune out early in a track.
+type TuneOutReader interface {
+	// TuneOutRate returns nil until a play of the track has a known audience.
+	TuneOutRate(ctx context.Context, id track.TrackID) (*float64, error)
+}
+
 // GetTrackHandler handles the get track use case.
 type GetTrackHandler struct {
-	repo track.Repository
+	repo     track.Repository
+	reaction ReactionCounter
+	tuneOut  TuneOutReader
 }
 
 // NewGetTrackHandler creates a new GetTrackHandler.
-func NewGetTrackHandler(repo track.Repository) *GetTrackHandler {
-	return &GetTrackHandler{repo: repo}
+func NewGetTrackHandler(repo track.Repository, reaction ReactionCounter, tuneOut TuneOutReader) *GetTrackHandler {
+	return &GetTrackHandler{repo: repo, reaction: reaction, tuneOut: tuneOut}
 }
 
 // Handle executes the get track use case.
@@ -28,13 +41,18 @@ func (h *GetTrackHandler) Handle(ctx context.Context, query GetTrackQuery) (*Tra
 		return nil, err
 	}
 
-	return &TrackDTO{
-		ID:        t.ID().String(),
-		Title:     t.Title().String(),
-		Cover:     t.Cover().String(),
-		Rotate:    t.Rotate(),
-		Likes:     t.Likes(),
-		Dislikes:  t.Dislikes(),
-		Listeners: t.Listeners(),
-	}, nil
+	counts, err := h.reaction.CountByTrack(ctx, trackID)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := h.tuneOut.TuneOutRate(ctx, trackID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newTrackDTO(t)
+	result.Reactions = counts
+	result.TuneOutRate = rate
+	return result, nil
 }
@@ -0,0 +1,145 @@
+package track
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/artist"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/track"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ErrInvalidSortOrder is returned when the sort order is neither asc nor desc.
+var ErrInvalidSortOrder = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"order must be 'asc' or 'desc'",
+)
+
+// ListTracksHandler handles the list tracks use case.
+type ListTracksHandler struct {
+	repo track.Repository
+}
+
+// NewListTracksHandler creates a new ListTracksHandler.
+func NewListTracksHandler(repo track.Repository) *ListTracksHandler {
+	return &ListTracksHandler{repo: repo}
+}
+
+// Handle executes the list tracks use case.
+func (h *ListTracksHandler) Handle(ctx context.Context, query ListTracksQuery) (*TrackListResult, error) {
+	sortBy, err := track.NewSortField(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	var descending bool
+	switch query.Order {
+	case "", "desc":
+		descending = true
+	case "asc":
+		descending = false
+	default:
+		return nil, ErrInvalidSortOrder
+	}
+
+	if query.Artist != "" {
+		if _, err := artist.ParseSlug(query.Artist); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	criteria := track.Criteria{
+		Search:     strings.TrimSpace(query.Search),
+		Artist:     query.Artist,
+		SortBy:     sortBy,
+		Descending: descending,
+		// Fetch one extra row to know whether another page exists.
+		Limit: limit + 1,
+	}
+
+	// The cursor pins the sort so it can't be replayed against another ordering.
+	order := "asc"
+	if descending {
+		order = "desc"
+	}
+
+	if query.Cursor != "" {
+		values, err := appshared.DecodeCursor(query.Cursor, 4)
+		if err != nil {
+			return nil, err
+		}
+		if values[0] != sortBy.String() || values[1] != order {
+			return nil, appshared.ErrInvalidCursor
+		}
+		id, err := track.NewTrackID(values[3])
+		if err != nil || !isValidSortValue(sortBy, values[2]) {
+			return nil, appshared.ErrInvalidCursor
+		}
+		criteria.After = &track.Position{Value: values[2], ID: id}
+	}
+
+	tracks, err := h.repo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TrackListResult{}
+	if len(tracks) > limit {
+		tracks = tracks[:limit]
+		last := tracks[len(tracks)-1]
+		result.NextCursor = appshared.EncodeCursor(sortBy.String(), order, sortValue(last, sortBy), last.ID().String())
+	}
+
+	result.Tracks = make([]*TrackDTO, len(tracks))
+	for i, t := range tracks {
+		result.Tracks[i] = newTrackDTO(t)
+	}
+
+	return result, nil
+}
+
+// sortValue returns the canonical text form of a track's sort field value.
+func sortValue(t *track.Track, sortBy track.SortField) string {
+	switch sortBy {
+	case track.SortByRotate:
+		return strconv.Itoa(t.Rotate())
+	case track.SortByLikes:
+		return strconv.Itoa(t.Likes())
+	case track.SortByDislikes:
+		return strconv.Itoa(t.Dislikes())
+	case track.SortByListeners:
+		return strconv.Itoa(t.Listeners())
+	case track.SortByUpdatedAt:
+		return t.UpdatedAt().Format(time.RFC3339Nano)
+	default:
+		return t.CreatedAt().Format(time.RFC3339Nano)
+	}
+}
+
+// isValidSortValue checks that a cursor value parses as the sort field's type.
+func isValidSortValue(sortBy track.SortField, value string) bool {
+	switch sortBy {
+	case track.SortByCreatedAt, track.SortByUpdatedAt:
+		_, err := time.Parse(time.RFC3339Nano, value)
+		return err == nil
+	default:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	}
+}
@@ -0,0 +1,172 @@
+package track
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/track"
+)
+
+// fakeTrackRepository serves its tracks in stored order, as if already sorted
+// by whatever criteria were asked for.
+type fakeTrackRepository struct {
+	track.Repository
+
+	tracks   []*track.Track
+	criteria []track.Criteria
+}
+
+func (r *fakeTrackRepository) FindByCriteria(_ context.Context, criteria track.Criteria) ([]*track.Track, error) {
+	r.criteria = append(r.criteria, criteria)
+
+	start := 0
+	if criteria.After != nil {
+		for i, t := range r.tracks {
+			if t.ID().Equals(criteria.After.ID) {
+				start = i + 1
+			}
+		}
+	}
+
+	end := min(start+criteria.Limit, len(r.tracks))
+	return r.tracks[start:end], nil
+}
+
+func newTestTrack(t *testing.T, n int, createdAt time.Time) *track.Track {
+	t.Helper()
+
+	tr, err := track.ReconstructTrack(
+		fmt.Sprintf("%032x", n), fmt.Sprintf("Artist - Song %d", n), "Artist", fmt.Sprintf("Song %d", n),
+		nil, "", n, 0, 0, 0, createdAt, createdAt,
+	)
+	if err != nil {
+		t.Fatalf("failed to build track: %v", err)
+	}
+	return tr
+}
+
+func TestListTracksHandlerPaginates(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 123456789, time.UTC)
+	repo := &fakeTrackRepository{}
+	for i := range 3 {
+		repo.tracks = append(repo.tracks, newTestTrack(t, i+1, base.Add(-time.Duration(i)*time.Hour)))
+	}
+	h := NewListTracksHandler(repo)
+	ctx := context.Background()
+
+	first, err := h.Handle(ctx, ListTracksQuery{Limit: 2})
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+	if len(first.Tracks) != 2 {
+		t.Fatalf("first page has %d tracks, want 2", len(first.Tracks))
+	}
+	if first.NextCursor == "" {
+		t.Fatal("first page has no next cursor")
+	}
+	if got := repo.criteria[0].Limit; got != 3 {
+		t.Errorf("first page fetched %d rows, want one extra: 3", got)
+	}
+
+	second, err := h.Handle(ctx, ListTracksQuery{Limit: 2, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+	if len(second.Tracks) != 1 || second.Tracks[0].ID != repo.tracks[2].ID().String() {
+		t.Fatalf("second page = %+v, want only the third track", second.Tracks)
+	}
+	if second.NextCursor != "" {
+		t.Errorf("last page has next cursor %q, want none", second.NextCursor)
+	}
+
+	// The cursor carries the last track of the first page, with its
+	// timestamp down to the nanosecond
+	after := repo.criteria[1].After
+	if after == nil {
+		t.Fatal("second page was not continued after the cursor")
+	}
+	if !after.ID.Equals(repo.tracks[1].ID()) {
+		t.Errorf("cursor ID = %s, want %s", after.ID, repo.tracks[1].ID())
+	}
+	at, err := time.Parse(time.RFC3339Nano, after.Value)
+	if err != nil {
+		t.Fatalf("cursor value %q is not RFC3339Nano: %v", after.Value, err)
+	}
+	if !at.Equal(repo.tracks[1].CreatedAt()) {
+		t.Errorf("cursor time = %s, want %s", at, repo.tracks[1].CreatedAt())
+	}
+}
+
+func TestListTracksHandlerLastPage(t *testing.T) {
+	repo := &fakeTrackRepository{tracks: []*track.Track{newTestTrack(t, 1, time.Now())}}
+
+	result, err := NewListTracksHandler(repo).Handle(context.Background(), ListTracksQuery{Limit: 1})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if len(result.Tracks) != 1 {
+		t.Fatalf("page has %d tracks, want 1", len(result.Tracks))
+	}
+	if result.NextCursor != "" {
+		t.Errorf("last page has next cursor %q, want none", result.NextCursor)
+	}
+}
+
+func TestListTracksHandlerRejectsCursor(t *testing.T) {
+	id := fmt.Sprintf("%032x", 1)
+	tests := []struct {
+		name   string
+		query  ListTracksQuery
+		cursor string
+	}{
+		{
+			name:   "other sort",
+			query:  ListTracksQuery{Sort: "likes"},
+			cursor: appshared.EncodeCursor("created_at", "desc", time.Now().Format(time.RFC3339Nano), id),
+		},
+		{
+			name:   "other order",
+			query:  ListTracksQuery{Order: "asc"},
+			cursor: appshared.EncodeCursor("created_at", "desc", time.Now().Format(time.RFC3339Nano), id),
+		},
+		{
+			name:   "malformed timestamp",
+			query:  ListTracksQuery{},
+			cursor: appshared.EncodeCursor("created_at", "desc", "yesterday", id),
+		},
+		{
+			name:   "non-numeric count",
+			query:  ListTracksQuery{Sort: "rotate"},
+			cursor: appshared.EncodeCursor("rotate", "desc", "many", id),
+		},
+		{
+			name:   "invalid track ID",
+			query:  ListTracksQuery{Sort: "rotate"},
+			cursor: appshared.EncodeCursor("rotate", "desc", "3", "not-a-track"),
+		},
+		{
+			name:   "not base64",
+			query:  ListTracksQuery{},
+			cursor: "!!!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeTrackRepository{}
+			tt.query.Cursor = tt.cursor
+
+			_, err := NewListTracksHandler(repo).Handle(context.Background(), tt.query)
+			if !errors.Is(err, appshared.ErrInvalidCursor) {
+				t.Errorf("err = %v, want ErrInvalidCursor", err)
+			}
+			if len(repo.criteria) != 0 {
+				t.Error("repository was queried with a rejected cursor")
+			}
+		})
+	}
+}
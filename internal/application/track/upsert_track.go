@@ -11,13 +11,17 @@ import (
 // UpsertTrackHandler handles the upsert track use case.
 type UpsertTrackHandler struct {
 	repo      track.Repository
+	parser    *track.TitleParser
+	uow       appshared.UnitOfWork
 	publisher appshared.EventPublisher
 }
 
 // NewUpsertTrackHandler creates a new UpsertTrackHandler.
-func NewUpsertTrackHandler(repo track.Repository, publisher appshared.EventPublisher) *UpsertTrackHandler {
+func NewUpsertTrackHandler(repo track.Repository, parser *track.TitleParser, uow appshared.UnitOfWork, publisher appshared.EventPublisher) *UpsertTrackHandler {
 	return &UpsertTrackHandler{
 		repo:      repo,
+		parser:    parser,
+		uow:       uow,
 		publisher: publisher,
 	}
 }
@@ -35,38 +39,42 @@ func (h *UpsertTrackHandler) Handle(ctx context.Context, cmd UpsertTrackCommand)
 		return nil, err
 	}
 
+	name := h.parser.Parse(title.String())
 	cover := track.NewCover(cmd.Cover)
 
-	// Check if track exists
-	existing, err := h.repo.FindByID(ctx, trackID)
-	if err != nil && !errors.Is(err, track.ErrTrackNotFound) {
-		return nil, err
-	}
-
+	// Read, update and persist the track and record its events in one
+	// transaction, the track locked so concurrent upserts don't lose rotations
 	var t *track.Track
-	if existing != nil {
-		// Update existing track
-		t = existing
-		t.IncrementRotation()
-		t.UpdateCover(cover)
-	} else {
-		// Create new track
-		t = track.NewTrack(trackID, title, cover)
-	}
+	err = appshared.WithinTransaction(ctx, h.uow, func(ctx context.Context) error {
+		existing, err := h.repo.FindByIDForUpdate(ctx, trackID)
+		if err != nil && !errors.Is(err, track.ErrTrackNotFound) {
+			return err
+		}
 
-	// Persist
-	if err := h.repo.Save(ctx, t); err != nil {
-		return nil, err
-	}
+		if existing != nil {
+			// Update existing track
+			t = existing
+			t.IncrementRotation()
+			t.UpdateCover(cover)
+			t.UpdateName(name)
+		} else {
+			// Create new track
+			t = track.NewTrack(trackID, title, name, cover)
+		}
+
+		if err := h.repo.Save(ctx, t); err != nil {
+			return err
+		}
 
-	// Publish events
-	if h.publisher != nil && t.HasEvents() {
-		if err := h.publisher.PublishAll(ctx, t.Events()); err != nil {
-			// Log error but don't fail the operation
-			// Events can be retried later
+		if h.publisher != nil && t.HasEvents() {
+			return h.publisher.PublishAll(ctx, t.Events())
 		}
-		t.ClearEvents()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	t.ClearEvents()
 
 	return &UpsertTrackResult{Rotate: t.Rotate()}, nil
 }
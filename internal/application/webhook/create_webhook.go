@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"context"
+
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/webhook"
+)
+
+// CreateWebhookHandler handles the create webhook use case.
+type CreateWebhookHandler struct {
+	repo     webhook.Repository
+	stations *appstation.Registry
+}
+
+// NewCreateWebhookHandler creates a new CreateWebhookHandler.
+func NewCreateWebhookHandler(repo webhook.Repository, stations *appstation.Registry) *CreateWebhookHandler {
+	return &CreateWebhookHandler{repo: repo, stations: stations}
+}
+
+// Handle executes the create webhook use case.
+func (h *CreateWebhookHandler) Handle(ctx context.Context, cmd CreateWebhookCommand) (*CreateWebhookResult, error) {
+	var stationID int64
+	if cmd.Station != "" {
+		s, err := h.stations.Get(cmd.Station)
+		if err != nil {
+			return nil, err
+		}
+		stationID = s.ID()
+	}
+
+	w, err := webhook.NewWebhook(cmd.URL, cmd.Events, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Save(ctx, w); err != nil {
+		return nil, err
+	}
+
+	return &CreateWebhookResult{Webhook: newWebhookDTO(w, h.stations), Secret: w.Secret()}, nil
+}
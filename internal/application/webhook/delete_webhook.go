@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"context"
+
+	"hub/internal/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// DeleteWebhookHandler handles the delete webhook use case.
+type DeleteWebhookHandler struct {
+	repo webhook.Repository
+}
+
+// NewDeleteWebhookHandler creates a new DeleteWebhookHandler.
+func NewDeleteWebhookHandler(repo webhook.Repository) *DeleteWebhookHandler {
+	return &DeleteWebhookHandler{repo: repo}
+}
+
+// Handle executes the delete webhook use case.
+func (h *DeleteWebhookHandler) Handle(ctx context.Context, cmd DeleteWebhookCommand) error {
+	if _, err := uuid.Parse(cmd.ID); err != nil {
+		return webhook.ErrWebhookNotFound
+	}
+
+	return h.repo.Delete(ctx, cmd.ID)
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/webhook"
+)
+
+// CreateWebhookCommand represents the command to subscribe a URL to events.
+type CreateWebhookCommand struct {
+	URL     string
+	Events  []string // event names such as track.rotated, or "*" for all
+	Station string   // slug of the only station subscribed to, empty for every station
+}
+
+// CreateWebhookResult represents a newly created webhook.
+// Secret is only available at creation time.
+type CreateWebhookResult struct {
+	Webhook *WebhookDTO
+	Secret  string
+}
+
+// DeleteWebhookCommand represents the command to delete a webhook.
+type DeleteWebhookCommand struct {
+	ID string
+}
+
+// ListDeliveriesQuery represents the query to read a webhook's delivery log.
+type ListDeliveriesQuery struct {
+	WebhookID string
+	Status    string // pending, succeeded or dead; empty for all
+	Limit     int
+}
+
+// WebhookDTO represents a webhook for external use. It never carries the secret.
+type WebhookDTO struct {
+	ID        string
+	URL       string
+	Events    []string
+	Station   string // empty for every station
+	CreatedAt time.Time
+}
+
+// DeliveryDTO represents a delivery log entry.
+type DeliveryDTO struct {
+	ID             int64
+	Event          string
+	Station        string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// newWebhookDTO maps a Webhook entity to a WebhookDTO.
+func newWebhookDTO(w *webhook.Webhook, stations *appstation.Registry) *WebhookDTO {
+	return &WebhookDTO{
+		ID:        w.ID(),
+		URL:       w.URL(),
+		Events:    w.Events(),
+		Station:   stationSlug(stations, w.StationID()),
+		CreatedAt: w.CreatedAt(),
+	}
+}
+
+// stationSlug returns the slug of a station, "" for 0 (every station) or the
+// ID of a station no longer configured.
+func stationSlug(stations *appstation.Registry, id int64) string {
+	if id == 0 {
+		return ""
+	}
+	s, err := stations.ByID(id)
+	if err != nil {
+		return strconv.FormatInt(id, 10)
+	}
+	return s.Slug()
+}
+
+// newDeliveryDTO maps a Delivery to a DeliveryDTO.
+func newDeliveryDTO(d *webhook.Delivery) *DeliveryDTO {
+	return &DeliveryDTO{
+		ID:             d.ID(),
+		Event:          d.EventName(),
+		Station:        d.Station(),
+		Payload:        d.Payload(),
+		Status:         d.Status().String(),
+		Attempts:       d.Attempts(),
+		ResponseStatus: d.ResponseStatus(),
+		LastError:      d.LastError(),
+		NextAttemptAt:  d.NextAttemptAt(),
+		CreatedAt:      d.CreatedAt(),
+		DeliveredAt:    d.DeliveredAt(),
+	}
+}
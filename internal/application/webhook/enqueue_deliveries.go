@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appshared "hub/internal/application/shared"
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/webhook"
+)
+
+// EnqueueDeliveriesHandler queues a delivery of each event to the webhooks subscribed to it.
+// The deliveries are posted by the webhook worker.
+type EnqueueDeliveriesHandler struct {
+	repo       webhook.Repository
+	deliveries webhook.DeliveryRepository
+	stations   *appstation.Registry
+}
+
+// NewEnqueueDeliveriesHandler creates a new EnqueueDeliveriesHandler.
+func NewEnqueueDeliveriesHandler(repo webhook.Repository, deliveries webhook.DeliveryRepository, stations *appstation.Registry) *EnqueueDeliveriesHandler {
+	return &EnqueueDeliveriesHandler{repo: repo, deliveries: deliveries, stations: stations}
+}
+
+// HandleEvent enqueues the event's payload for every webhook subscribed to it
+// on the context's station, tagged with the station's slug.
+// A redelivered event is enqueued once per webhook.
+func (h *EnqueueDeliveriesHandler) HandleEvent(ctx context.Context, event shared.DomainEvent) error {
+	webhooks, err := h.repo.FindByEvent(ctx, event.EventName())
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event.Payload())
+	if err != nil {
+		return fmt.Errorf("failed to encode %s payload: %w", event.EventName(), err)
+	}
+
+	eventID := appshared.EventID(ctx)
+	station := stationSlug(h.stations, appshared.StationID(ctx))
+	deliveries := make([]*webhook.Delivery, len(webhooks))
+	for i, w := range webhooks {
+		deliveries[i] = webhook.NewDelivery(w.ID(), eventID, event.EventName(), station, payload)
+	}
+
+	return h.deliveries.Enqueue(ctx, deliveries)
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+
+	"hub/internal/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 200
+)
+
+// ListDeliveriesHandler handles the list deliveries use case.
+type ListDeliveriesHandler struct {
+	repo       webhook.Repository
+	deliveries webhook.DeliveryRepository
+}
+
+// NewListDeliveriesHandler creates a new ListDeliveriesHandler.
+func NewListDeliveriesHandler(repo webhook.Repository, deliveries webhook.DeliveryRepository) *ListDeliveriesHandler {
+	return &ListDeliveriesHandler{repo: repo, deliveries: deliveries}
+}
+
+// Handle returns a webhook's delivery log, newest first.
+func (h *ListDeliveriesHandler) Handle(ctx context.Context, query ListDeliveriesQuery) ([]*DeliveryDTO, error) {
+	if _, err := uuid.Parse(query.WebhookID); err != nil {
+		return nil, webhook.ErrWebhookNotFound
+	}
+	if _, err := h.repo.FindByID(ctx, query.WebhookID); err != nil {
+		return nil, err
+	}
+
+	criteria := webhook.DeliveryCriteria{Limit: query.Limit}
+	if criteria.Limit <= 0 {
+		criteria.Limit = defaultDeliveriesLimit
+	}
+	if criteria.Limit > maxDeliveriesLimit {
+		criteria.Limit = maxDeliveriesLimit
+	}
+
+	if query.Status != "" {
+		status, err := webhook.NewDeliveryStatus(query.Status)
+		if err != nil {
+			return nil, err
+		}
+		criteria.Status = &status
+	}
+
+	deliveries, err := h.deliveries.FindByWebhook(ctx, query.WebhookID, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*DeliveryDTO, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = newDeliveryDTO(d)
+	}
+	return result, nil
+}
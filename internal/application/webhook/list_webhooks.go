@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/webhook"
+)
+
+// ListWebhooksHandler handles the list webhooks use case.
+type ListWebhooksHandler struct {
+	repo     webhook.Repository
+	stations *appstation.Registry
+}
+
+// NewListWebhooksHandler creates a new ListWebhooksHandler.
+func NewListWebhooksHandler(repo webhook.Repository, stations *appstation.Registry) *ListWebhooksHandler {
+	return &ListWebhooksHandler{repo: repo, stations: stations}
+}
+
+// Handle executes the list webhooks use case.
+func (h *ListWebhooksHandler) Handle(ctx context.Context) ([]*WebhookDTO, error) {
+	webhooks, err := h.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*WebhookDTO, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = newWebhookDTO(w, h.stations)
+	}
+	return result, nil
+}
@@ -2,6 +2,11 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"hub/internal/domain/station"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
@@ -10,15 +15,32 @@ import (
 type (
 	Config interface {
 		Port() int
+		MetricsPort() int
 		LogLevel() string
 		DatabaseConnection() (string, int, int)
 		RedisConnection() (string, string)
-		IcecastConnection() (string, string, string, string)
+		Stations() []string
+		StationName(slug string) string
+		StreamingConnection(station string) (string, string, string, []string)
+		StreamingBackend(station string) string
+		StreamingHTTP() (time.Duration, int, time.Duration)
+		StreamingBreaker() (int, time.Duration)
 		SchedulerEnabled() bool
+		LeaderElectionInterval() time.Duration
+		Job(name string) (string, bool, string, time.Duration)
+		StatisticsLimit() int
+		TuneOutWindow() time.Duration
+		TitleSeparators() []string
+		Identity() (string, time.Duration, bool)
+		IdentityRateLimit() (int, time.Duration)
+		Outbox() (time.Duration, int, int)
+		Webhooks() (time.Duration, time.Duration, int)
+		EventBus() (string, string, string, string)
 	}
 	config struct {
-		port     int
-		logLevel string
+		port        int
+		metricsPort int
+		logLevel    string
 
 		dbHost string
 		dbPort int
@@ -29,11 +51,15 @@ type (
 		dbMaxConns int
 		dbMinConns int
 
-		icecastHost     string
-		icecastPort     int
-		icecastUser     string
-		icecastPassword string
-		icecastMount    string
+		stations  []string
+		streaming map[string]streamingConfig
+		names     map[string]string
+
+		streamTimeout         time.Duration
+		streamRetries         int
+		streamRetryBackoff    time.Duration
+		streamBreakerFailures int
+		streamBreakerCooldown time.Duration
 
 		redis_host     string
 		redis_port     int
@@ -42,10 +68,73 @@ type (
 		redis_db       int
 		redis_prefix   string
 
-		scheduler bool
+		scheduler              bool
+		leaderElectionInterval time.Duration
+		jobs                   map[string]jobConfig
+
+		statisticsLimit int
+		tuneOutWindow   time.Duration
+
+		titleSeparators []string
+
+		identitySecret      string
+		identityTokenTTL    time.Duration
+		identityAllowHeader bool
+		identityRateLimit   int
+		identityRateWindow  time.Duration
+
+		outboxPollInterval time.Duration
+		outboxBatchSize    int
+		outboxMaxAttempts  int
+
+		webhookPollInterval time.Duration
+		webhookTimeout      time.Duration
+		webhookMaxAttempts  int
+
+		eventBus       string
+		eventBusStream string
+		eventBusGroup  string
+		eventBusStart  string
+	}
+
+	streamingConfig struct {
+		backend  string
+		host     string
+		port     int
+		user     string
+		password string
+		mounts   []string
+	}
+
+	jobConfig struct {
+		spec    string
+		enabled bool
+		overlap string
+		timeout time.Duration
 	}
 )
 
+// jobDefaults holds the default schedule of each job, overridable with
+// JOB_<NAME>_SPEC, _ENABLED, _OVERLAP and _TIMEOUT.
+var jobDefaults = map[string]jobConfig{
+	"track_listeners": {spec: "*/3 * * * * *", enabled: true, overlap: "skip", timeout: 10 * time.Second},
+	"prune_job_runs":  {spec: "0 0 * * * *", enabled: true, overlap: "skip", timeout: 5 * time.Minute},
+	"prune_outbox":    {spec: "0 30 * * * *", enabled: true, overlap: "skip", timeout: 5 * time.Minute},
+}
+
+// Event bus drivers.
+const (
+	EventBusMemory = "memory"
+	EventBusRedis  = "redis"
+)
+
+// Streaming server backends.
+const (
+	IcecastAdmin  = "admin"
+	IcecastStatus = "status"
+	ShoutcastV2   = "shoutcast"
+)
+
 func NewConfig() Config {
 	_ = godotenv.Load()
 	viper.AutomaticEnv()
@@ -61,11 +150,33 @@ func NewConfig() Config {
 	viper.SetDefault("DB_MIN_CONNS", "1")
 	viper.SetDefault("DB_MAX_CONNS", "4")
 
-	viper.SetDefault("ICECAST_HOST", "127.0.0.1")
-	viper.SetDefault("ICECAST_PORT", "8000")
-	viper.SetDefault("ICECAST_USER", "admin")
-	viper.SetDefault("ICECAST_PASSWORD", "changeme")
-	viper.SetDefault("ICECAST_MOUNT", "/mp3")
+	// The ICECAST_* names of these settings are deprecated but still read
+	aliasIcecastEnv()
+	viper.SetDefault("STREAM_HOST", "127.0.0.1")
+	viper.SetDefault("STREAM_PORT", "8000")
+	viper.SetDefault("STREAM_USER", "admin")
+	viper.SetDefault("STREAM_PASSWORD", "changeme")
+	viper.SetDefault("STREAM_MOUNT", "/mp3")
+	// Comma-separated mounts of the same channel; STREAM_MOUNT when empty
+	viper.SetDefault("STREAM_MOUNTS", "")
+	// "admin" reads the authenticated Icecast admin XML; "status" the public
+	// status-json.xsl, which has no per-listener data; "shoutcast" a
+	// SHOUTcast v2 server
+	viper.SetDefault("STREAM_BACKEND", IcecastAdmin)
+	// Per attempt; failed requests are retried with jittered backoff. All
+	// attempts and backoffs together must fit JOB_TRACK_LISTENERS_TIMEOUT
+	viper.SetDefault("STREAM_TIMEOUT", "2s")
+	viper.SetDefault("STREAM_RETRIES", "1")
+	viper.SetDefault("STREAM_RETRY_BACKOFF", "200ms")
+	// Consecutive failures before a server is no longer called for the
+	// cooldown; 0 disables the circuit breaker
+	viper.SetDefault("STREAM_BREAKER_FAILURES", "5")
+	viper.SetDefault("STREAM_BREAKER_COOLDOWN", "30s")
+
+	// Comma-separated slugs of the stations besides the default one, each
+	// set up with STATION_<SLUG>_NAME and STATION_<SLUG>_STREAM_*
+	viper.SetDefault("STATIONS", "")
+	viper.SetDefault("STATION_MAIN_NAME", "Main")
 
 	viper.SetDefault("REDIS_HOST", "127.0.0.1")
 	viper.SetDefault("REDIS_PORT", "6379")
@@ -74,11 +185,60 @@ func NewConfig() Config {
 	viper.SetDefault("REDIS_DB", "1")
 	viper.SetDefault("REDIS_PREFIX", "be___")
 
+	// 0 serves /metrics on the main port
+	viper.SetDefault("METRICS_PORT", "0")
+
 	viper.SetDefault("SCHEDULER_ENABLED", "true")
+	// How often replicas campaign for the scheduler leadership; a dead
+	// leader is replaced within this interval
+	viper.SetDefault("LEADER_ELECTION_INTERVAL", "2s")
+	for name, job := range jobDefaults {
+		key := "JOB_" + strings.ToUpper(name)
+		viper.SetDefault(key+"_SPEC", job.spec)
+		viper.SetDefault(key+"_ENABLED", job.enabled)
+		viper.SetDefault(key+"_OVERLAP", job.overlap)
+		viper.SetDefault(key+"_TIMEOUT", job.timeout)
+	}
+
+	viper.SetDefault("STATISTICS_LIMIT", "5")
+	// Listeners leaving this soon after a track starts count as tuning out of it
+	viper.SetDefault("TUNE_OUT_WINDOW", "30s")
+
+	// Artist/song separators, "|"-delimited so surrounding spaces are kept
+	viper.SetDefault("TITLE_SEPARATORS", " - | – | — ")
+
+	// No default: startup fails unless a secret of at least 32 bytes is set
+	viper.SetDefault("IDENTITY_SECRET", "")
+	viper.SetDefault("IDENTITY_TOKEN_TTL", "8760h")
+	// Trust the unsigned X-User-ID header while clients migrate to tokens
+	viper.SetDefault("IDENTITY_ALLOW_USER_ID_HEADER", "false")
+	// Tokens issued per client IP and window; 0 disables the limit
+	viper.SetDefault("IDENTITY_RATE_LIMIT", "10")
+	viper.SetDefault("IDENTITY_RATE_WINDOW", "1h")
+
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", "1s")
+	viper.SetDefault("OUTBOX_BATCH_SIZE", "100")
+	// Failed deliveries are dead-lettered after this many attempts
+	viper.SetDefault("OUTBOX_MAX_ATTEMPTS", "10")
+
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", "2s")
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", "8")
+
+	// "memory" keeps events in-process; "redis" shares them between replicas
+	viper.SetDefault("EVENT_BUS", EventBusMemory)
+	viper.SetDefault("EVENT_BUS_STREAM", "events")
+	viper.SetDefault("EVENT_BUS_GROUP", "hub")
+	// Stream ID new consumer groups start reading after: "$" for entries
+	// appended from then on, "0" to replay the whole stream
+	viper.SetDefault("EVENT_BUS_GROUP_START", "$")
+
+	stations := loadStations()
 
 	return &config{
-		port:     viper.GetInt("PORT"),
-		logLevel: viper.GetString("LOG_LEVEL"),
+		port:        viper.GetInt("PORT"),
+		metricsPort: viper.GetInt("METRICS_PORT"),
+		logLevel:    viper.GetString("LOG_LEVEL"),
 
 		dbHost: viper.GetString("DB_HOST"),
 		dbPort: viper.GetInt("DB_PORT"),
@@ -89,11 +249,15 @@ func NewConfig() Config {
 		dbMaxConns: viper.GetInt("DB_MAX_CONNS"),
 		dbMinConns: viper.GetInt("DB_MIN_CONNS"),
 
-		icecastHost:     viper.GetString("ICECAST_HOST"),
-		icecastPort:     viper.GetInt("ICECAST_PORT"),
-		icecastUser:     viper.GetString("ICECAST_USER"),
-		icecastPassword: viper.GetString("ICECAST_PASSWORD"),
-		icecastMount:    viper.GetString("ICECAST_MOUNT"),
+		stations:  stations,
+		streaming: loadStreaming(stations),
+		names:     loadStationNames(stations),
+
+		streamTimeout:         viper.GetDuration("STREAM_TIMEOUT"),
+		streamRetries:         viper.GetInt("STREAM_RETRIES"),
+		streamRetryBackoff:    viper.GetDuration("STREAM_RETRY_BACKOFF"),
+		streamBreakerFailures: viper.GetInt("STREAM_BREAKER_FAILURES"),
+		streamBreakerCooldown: viper.GetDuration("STREAM_BREAKER_COOLDOWN"),
 
 		redis_host:     viper.GetString("REDIS_HOST"),
 		redis_port:     viper.GetInt("REDIS_PORT"),
@@ -102,7 +266,33 @@ func NewConfig() Config {
 		redis_db:       viper.GetInt("REDIS_DB"),
 		redis_prefix:   viper.GetString("REDIS_PREFIX"),
 
-		scheduler: viper.GetBool("SCHEDULER_ENABLED"),
+		scheduler:              viper.GetBool("SCHEDULER_ENABLED"),
+		leaderElectionInterval: viper.GetDuration("LEADER_ELECTION_INTERVAL"),
+		jobs:                   loadJobs(),
+
+		statisticsLimit: viper.GetInt("STATISTICS_LIMIT"),
+		tuneOutWindow:   viper.GetDuration("TUNE_OUT_WINDOW"),
+
+		titleSeparators: strings.Split(viper.GetString("TITLE_SEPARATORS"), "|"),
+
+		identitySecret:      viper.GetString("IDENTITY_SECRET"),
+		identityTokenTTL:    viper.GetDuration("IDENTITY_TOKEN_TTL"),
+		identityAllowHeader: viper.GetBool("IDENTITY_ALLOW_USER_ID_HEADER"),
+		identityRateLimit:   viper.GetInt("IDENTITY_RATE_LIMIT"),
+		identityRateWindow:  viper.GetDuration("IDENTITY_RATE_WINDOW"),
+
+		outboxPollInterval: viper.GetDuration("OUTBOX_POLL_INTERVAL"),
+		outboxBatchSize:    viper.GetInt("OUTBOX_BATCH_SIZE"),
+		outboxMaxAttempts:  viper.GetInt("OUTBOX_MAX_ATTEMPTS"),
+
+		webhookPollInterval: viper.GetDuration("WEBHOOK_POLL_INTERVAL"),
+		webhookTimeout:      viper.GetDuration("WEBHOOK_TIMEOUT"),
+		webhookMaxAttempts:  viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+
+		eventBus:       viper.GetString("EVENT_BUS"),
+		eventBusStream: viper.GetString("EVENT_BUS_STREAM"),
+		eventBusGroup:  viper.GetString("EVENT_BUS_GROUP"),
+		eventBusStart:  viper.GetString("EVENT_BUS_GROUP_START"),
 	}
 }
 
@@ -110,6 +300,10 @@ func (c *config) Port() int {
 	return c.port
 }
 
+func (c *config) MetricsPort() int {
+	return c.metricsPort
+}
+
 func (c *config) LogLevel() string {
 	return c.logLevel
 }
@@ -121,11 +315,41 @@ func (c *config) DatabaseConnection() (string, int, int) {
 	), c.dbMinConns, c.dbMaxConns
 }
 
-func (c *config) IcecastConnection() (string, string, string, string) {
-	return fmt.Sprintf("http://%s:%d", c.icecastHost, c.icecastPort),
-		c.icecastUser,
-		c.icecastPassword,
-		c.icecastMount
+// Stations returns the slugs of the configured stations, the default one first.
+func (c *config) Stations() []string {
+	return c.stations
+}
+
+func (c *config) StationName(slug string) string {
+	return c.names[slug]
+}
+
+// StreamingConnection returns the streaming server URL, credentials and
+// mounts of a station, the primary mount first.
+func (c *config) StreamingConnection(station string) (string, string, string, []string) {
+	sc := c.streaming[station]
+	return fmt.Sprintf("http://%s:%d", sc.host, sc.port),
+		sc.user,
+		sc.password,
+		sc.mounts
+}
+
+// StreamingBackend returns the streaming server a station runs and how it
+// is read: one of IcecastAdmin, IcecastStatus or ShoutcastV2.
+func (c *config) StreamingBackend(station string) string {
+	return c.streaming[station].backend
+}
+
+// StreamingHTTP returns the timeout of a streaming server request, the
+// number of retries of a failed one and the base delay between them.
+func (c *config) StreamingHTTP() (time.Duration, int, time.Duration) {
+	return c.streamTimeout, c.streamRetries, c.streamRetryBackoff
+}
+
+// StreamingBreaker returns the consecutive failures opening the circuit
+// breaker of a streaming server and how long it then stays open.
+func (c *config) StreamingBreaker() (int, time.Duration) {
+	return c.streamBreakerFailures, c.streamBreakerCooldown
 }
 
 func (c *config) RedisConnection() (string, string) {
@@ -137,3 +361,175 @@ func (c *config) RedisConnection() (string, string) {
 func (c *config) SchedulerEnabled() bool {
 	return c.scheduler
 }
+
+func loadJobs() map[string]jobConfig {
+	jobs := make(map[string]jobConfig, len(jobDefaults))
+	for name := range jobDefaults {
+		key := "JOB_" + strings.ToUpper(name)
+		jobs[name] = jobConfig{
+			spec:    viper.GetString(key + "_SPEC"),
+			enabled: viper.GetBool(key + "_ENABLED"),
+			overlap: viper.GetString(key + "_OVERLAP"),
+			timeout: viper.GetDuration(key + "_TIMEOUT"),
+		}
+	}
+	return jobs
+}
+
+// loadStations reads STATIONS, keeping the default station first.
+func loadStations() []string {
+	stations := []string{station.DefaultSlug}
+	seen := map[string]bool{station.DefaultSlug: true}
+	for _, slug := range strings.Split(viper.GetString("STATIONS"), ",") {
+		slug = strings.TrimSpace(slug)
+		if slug != "" && !seen[slug] {
+			seen[slug] = true
+			stations = append(stations, slug)
+		}
+	}
+	return stations
+}
+
+// stationKey returns the prefix of a station's settings, e.g. STATION_CHILL_OUT_.
+func stationKey(slug string) string {
+	return "STATION_" + strings.ToUpper(strings.ReplaceAll(slug, "-", "_")) + "_"
+}
+
+func loadStationNames(stations []string) map[string]string {
+	names := make(map[string]string, len(stations))
+	for _, slug := range stations {
+		names[slug] = viper.GetString(stationKey(slug) + "NAME")
+	}
+	return names
+}
+
+// loadStreaming reads the streaming server connection of every station. The
+// default station uses STREAM_*; the others STATION_<SLUG>_STREAM_*, falling
+// back to the default server and credentials but not to its mounts.
+func loadStreaming(stations []string) map[string]streamingConfig {
+	main := streamingConfig{
+		backend:  viper.GetString("STREAM_BACKEND"),
+		host:     viper.GetString("STREAM_HOST"),
+		port:     viper.GetInt("STREAM_PORT"),
+		user:     viper.GetString("STREAM_USER"),
+		password: viper.GetString("STREAM_PASSWORD"),
+		mounts:   parseMounts(viper.GetString("STREAM_MOUNTS")),
+	}
+	if len(main.mounts) == 0 {
+		main.mounts = parseMounts(viper.GetString("STREAM_MOUNT"))
+	}
+
+	configs := map[string]streamingConfig{station.DefaultSlug: main}
+	for _, slug := range stations[1:] {
+		key := stationKey(slug) + "STREAM_"
+		sc := main
+		if backend := viper.GetString(key + "BACKEND"); backend != "" {
+			sc.backend = backend
+		}
+		if host := viper.GetString(key + "HOST"); host != "" {
+			sc.host = host
+		}
+		if port := viper.GetInt(key + "PORT"); port != 0 {
+			sc.port = port
+		}
+		if user := viper.GetString(key + "USER"); user != "" {
+			sc.user = user
+		}
+		if password := viper.GetString(key + "PASSWORD"); password != "" {
+			sc.password = password
+		}
+		sc.mounts = parseMounts(viper.GetString(key + "MOUNTS"))
+		configs[slug] = sc
+	}
+	return configs
+}
+
+// aliasIcecastEnv reads the deprecated ICECAST_* and
+// STATION_<SLUG>_ICECAST_* variables as their STREAM_* counterparts, unless
+// those are set too.
+func aliasIcecastEnv() {
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+
+		var renamed string
+		switch {
+		case strings.HasPrefix(name, "ICECAST_"):
+			renamed = "STREAM_" + strings.TrimPrefix(name, "ICECAST_")
+		case strings.HasPrefix(name, "STATION_") && strings.Contains(name, "_ICECAST_"):
+			i := strings.LastIndex(name, "_ICECAST_")
+			renamed = name[:i] + "_STREAM_" + name[i+len("_ICECAST_"):]
+		default:
+			continue
+		}
+
+		if _, set := os.LookupEnv(renamed); !set {
+			viper.Set(renamed, value)
+		}
+	}
+}
+
+// parseMounts splits a comma-separated mount list, adding missing leading slashes.
+func parseMounts(raw string) []string {
+	var mounts []string
+	seen := make(map[string]bool)
+	for _, mount := range strings.Split(raw, ",") {
+		mount = strings.TrimSpace(mount)
+		if mount == "" {
+			continue
+		}
+		if !strings.HasPrefix(mount, "/") {
+			mount = "/" + mount
+		}
+		if !seen[mount] {
+			seen[mount] = true
+			mounts = append(mounts, mount)
+		}
+	}
+	return mounts
+}
+
+func (c *config) LeaderElectionInterval() time.Duration {
+	return c.leaderElectionInterval
+}
+
+// Job returns the spec, enabled flag, overlap policy and timeout of a job.
+func (c *config) Job(name string) (string, bool, string, time.Duration) {
+	job := c.jobs[name]
+	return job.spec, job.enabled, job.overlap, job.timeout
+}
+
+func (c *config) StatisticsLimit() int {
+	return c.statisticsLimit
+}
+
+func (c *config) TuneOutWindow() time.Duration {
+	return c.tuneOutWindow
+}
+
+func (c *config) TitleSeparators() []string {
+	return c.titleSeparators
+}
+
+func (c *config) Identity() (string, time.Duration, bool) {
+	return c.identitySecret, c.identityTokenTTL, c.identityAllowHeader
+}
+
+// IdentityRateLimit returns how many identity tokens a client IP may be
+// issued per window.
+func (c *config) IdentityRateLimit() (int, time.Duration) {
+	return c.identityRateLimit, c.identityRateWindow
+}
+
+func (c *config) Outbox() (time.Duration, int, int) {
+	return c.outboxPollInterval, c.outboxBatchSize, c.outboxMaxAttempts
+}
+
+func (c *config) Webhooks() (time.Duration, time.Duration, int) {
+	return c.webhookPollInterval, c.webhookTimeout, c.webhookMaxAttempts
+}
+
+// EventBus returns the event bus driver, its stream, the base name of its
+// consumer groups and the stream ID new groups start reading after.
+func (c *config) EventBus() (string, string, string, string) {
+	return c.eventBus, c.eventBusStream, c.eventBusGroup, c.eventBusStart
+}
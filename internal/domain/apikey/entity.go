@@ -0,0 +1,155 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// secretPrefix marks API key secrets so they are recognisable in configs and logs.
+	secretPrefix = "hub_"
+	// displayPrefixLength is how much of the secret is kept to identify a key in listings.
+	displayPrefixLength = 12
+)
+
+// APIKey represents a credential for machine clients such as the playout source.
+// Only the SHA-256 hash of the secret is stored. A key may be restricted to
+// one station; a station ID of 0 grants every station.
+type APIKey struct {
+	id         string
+	name       string
+	prefix     string
+	hash       string
+	scopes     []Scope
+	stationID  int64
+	createdAt  time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+}
+
+// Generate creates a new APIKey and returns it with its plaintext secret.
+// The secret cannot be recovered later.
+func Generate(name string, scopes []Scope, stationID int64) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrInvalidName
+	}
+	if len(scopes) == 0 {
+		return nil, "", ErrNoScopes
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	secret := secretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	return &APIKey{
+		id:        uuid.New().String(),
+		name:      name,
+		prefix:    secret[:displayPrefixLength],
+		hash:      HashSecret(secret),
+		scopes:    scopes,
+		stationID: stationID,
+		createdAt: time.Now(),
+	}, secret, nil
+}
+
+// ReconstructAPIKey rebuilds an APIKey from persistence data.
+func ReconstructAPIKey(
+	id, name, prefix, hash string,
+	scopes []string,
+	stationID int64,
+	createdAt time.Time,
+	lastUsedAt, revokedAt *time.Time,
+) (*APIKey, error) {
+	parsed := make([]Scope, 0, len(scopes))
+	for _, s := range scopes {
+		scope, err := NewScope(s)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, scope)
+	}
+
+	return &APIKey{
+		id:         id,
+		name:       name,
+		prefix:     prefix,
+		hash:       hash,
+		scopes:     parsed,
+		stationID:  stationID,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}, nil
+}
+
+// HashSecret returns the hex-encoded SHA-256 hash of a secret.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Revoke marks the key as revoked.
+// Returns ErrAPIKeyRevoked if the key is already revoked.
+func (k *APIKey) Revoke(at time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+	k.revokedAt = &at
+	return nil
+}
+
+// Allows returns true if the key grants the scope. Admin keys grant every scope.
+func (k *APIKey) Allows(scope Scope) bool {
+	for _, s := range k.scopes {
+		if s.Equals(scope) || s.Equals(ScopeAdmin) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsStation returns true if the key may be used for the station.
+func (k *APIKey) AllowsStation(stationID int64) bool {
+	return k.stationID == 0 || k.stationID == stationID
+}
+
+// Getters
+
+// ID returns the key ID.
+func (k *APIKey) ID() string { return k.id }
+
+// Name returns the human-readable key name.
+func (k *APIKey) Name() string { return k.name }
+
+// Prefix returns the first characters of the secret, used to identify the key.
+func (k *APIKey) Prefix() string { return k.prefix }
+
+// Hash returns the SHA-256 hash of the secret.
+func (k *APIKey) Hash() string { return k.hash }
+
+// Scopes returns the granted scopes.
+func (k *APIKey) Scopes() []Scope { return k.scopes }
+
+// StationID returns the station the key is restricted to, or 0 for every station.
+func (k *APIKey) StationID() int64 { return k.stationID }
+
+// CreatedAt returns when the key was created.
+func (k *APIKey) CreatedAt() time.Time { return k.createdAt }
+
+// LastUsedAt returns when the key was last used, or nil if never.
+func (k *APIKey) LastUsedAt() *time.Time { return k.lastUsedAt }
+
+// RevokedAt returns when the key was revoked, or nil if active.
+func (k *APIKey) RevokedAt() *time.Time { return k.revokedAt }
+
+// IsRevoked returns true if the key has been revoked.
+func (k *APIKey) IsRevoked() bool { return k.revokedAt != nil }
@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// Domain errors for API key operations.
+var (
+	ErrAPIKeyNotFound = shared.NewDomainError(
+		shared.ErrNotFound,
+		"API key not found",
+	)
+	ErrAPIKeyRevoked = shared.NewDomainError(
+		shared.ErrOperationFailed,
+		"API key has already been revoked",
+	)
+	ErrInvalidAPIKey = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"API key is unknown or revoked",
+	)
+	ErrInvalidName = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"API key name cannot be empty",
+	)
+	ErrNoScopes = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"API key needs at least one scope",
+	)
+)
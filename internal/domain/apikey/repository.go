@@ -0,0 +1,26 @@
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for API key persistence.
+type Repository interface {
+	// Save persists an API key.
+	Save(ctx context.Context, key *APIKey) error
+
+	// FindByID retrieves an API key by its ID.
+	// Returns ErrAPIKeyNotFound if the key doesn't exist.
+	FindByID(ctx context.Context, id string) (*APIKey, error)
+
+	// FindByHash retrieves an API key by the hash of its secret.
+	// Returns ErrAPIKeyNotFound if the key doesn't exist.
+	FindByHash(ctx context.Context, hash string) (*APIKey, error)
+
+	// FindAll retrieves every API key, newest first.
+	FindAll(ctx context.Context) ([]*APIKey, error)
+
+	// MarkUsed records when a key was last used.
+	MarkUsed(ctx context.Context, id string, at time.Time) error
+}
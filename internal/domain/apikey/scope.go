@@ -0,0 +1,46 @@
+package apikey
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// ErrInvalidScope is returned when a scope is not supported.
+var ErrInvalidScope = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"scope must be one of tracks:write, admin",
+)
+
+// Scope is a value object naming a permission granted to an API key.
+type Scope struct {
+	value string
+}
+
+// Supported scopes.
+var (
+	// ScopeTracksWrite allows reporting track changes from the playout source.
+	ScopeTracksWrite = Scope{value: "tracks:write"}
+	// ScopeAdmin allows every operation, including administrative endpoints.
+	ScopeAdmin = Scope{value: "admin"}
+)
+
+// NewScope creates a Scope from a string.
+func NewScope(value string) (Scope, error) {
+	switch value {
+	case "tracks:write":
+		return ScopeTracksWrite, nil
+	case "admin":
+		return ScopeAdmin, nil
+	default:
+		return Scope{}, ErrInvalidScope
+	}
+}
+
+// String returns the string representation of the Scope.
+func (s Scope) String() string {
+	return s.value
+}
+
+// Equals checks if two Scopes are equal.
+func (s Scope) Equals(other Scope) bool {
+	return s.value == other.value
+}
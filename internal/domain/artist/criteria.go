@@ -0,0 +1,52 @@
+package artist
+
+// SortField is a value object naming the total artists are ordered by.
+type SortField struct {
+	value string
+}
+
+// Supported sort fields.
+var (
+	SortByTracks    = SortField{value: "tracks"}
+	SortByRotate    = SortField{value: "rotate"}
+	SortByLikes     = SortField{value: "likes"}
+	SortByDislikes  = SortField{value: "dislikes"}
+	SortByListeners = SortField{value: "listeners"}
+	SortByName      = SortField{value: "name"}
+)
+
+// NewSortField creates a SortField from a string.
+// An empty string defaults to SortByRotate.
+func NewSortField(value string) (SortField, error) {
+	switch value {
+	case "", "rotate":
+		return SortByRotate, nil
+	case "tracks":
+		return SortByTracks, nil
+	case "likes":
+		return SortByLikes, nil
+	case "dislikes":
+		return SortByDislikes, nil
+	case "listeners":
+		return SortByListeners, nil
+	case "name":
+		return SortByName, nil
+	default:
+		return SortField{}, ErrInvalidSortField
+	}
+}
+
+// String returns the string representation of the SortField.
+func (f SortField) String() string {
+	return f.value
+}
+
+// Criteria describes an artist listing query.
+type Criteria struct {
+	// Search matches artist names case-insensitively; empty matches all artists.
+	Search     string
+	SortBy     SortField
+	Descending bool
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,61 @@
+package artist
+
+// Artist is a read model aggregating every track credited to the same main artist.
+// Artists are not stored on their own; they are derived from the parsed track names.
+type Artist struct {
+	slug       Slug
+	name       string
+	trackCount int
+	rotate     int
+	likes      int
+	dislikes   int
+	listeners  int
+}
+
+// ReconstructArtist rebuilds an Artist from persistence data.
+func ReconstructArtist(slug, name string, trackCount, rotate, likes, dislikes, listeners int) *Artist {
+	return &Artist{
+		slug:       Slug{value: slug},
+		name:       name,
+		trackCount: trackCount,
+		rotate:     rotate,
+		likes:      likes,
+		dislikes:   dislikes,
+		listeners:  listeners,
+	}
+}
+
+// Slug returns the artist slug.
+func (a *Artist) Slug() Slug {
+	return a.slug
+}
+
+// Name returns the most common spelling of the artist name.
+func (a *Artist) Name() string {
+	return a.name
+}
+
+// TrackCount returns the number of tracks credited to the artist.
+func (a *Artist) TrackCount() int {
+	return a.trackCount
+}
+
+// Rotate returns the total rotation count over the artist's tracks.
+func (a *Artist) Rotate() int {
+	return a.rotate
+}
+
+// Likes returns the total likes over the artist's tracks.
+func (a *Artist) Likes() int {
+	return a.likes
+}
+
+// Dislikes returns the total dislikes over the artist's tracks.
+func (a *Artist) Dislikes() int {
+	return a.dislikes
+}
+
+// Listeners returns the total listeners over the artist's tracks.
+func (a *Artist) Listeners() int {
+	return a.listeners
+}
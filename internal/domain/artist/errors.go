@@ -0,0 +1,21 @@
+package artist
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// Domain errors for artist operations.
+var (
+	ErrArtistNotFound = shared.NewDomainError(
+		shared.ErrNotFound,
+		"artist not found",
+	)
+	ErrInvalidSlug = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"invalid artist slug",
+	)
+	ErrInvalidSortField = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"sort must be one of tracks, rotate, likes, dislikes, listeners, name",
+	)
+)
@@ -0,0 +1,15 @@
+package artist
+
+import (
+	"context"
+)
+
+// Repository defines the interface for artist queries.
+type Repository interface {
+	// FindByCriteria retrieves artists matching the criteria.
+	FindByCriteria(ctx context.Context, criteria Criteria) ([]*Artist, error)
+
+	// FindBySlug retrieves an artist by its slug.
+	// Returns ErrArtistNotFound if no track is credited to the slug.
+	FindBySlug(ctx context.Context, slug Slug) (*Artist, error)
+}
@@ -0,0 +1,56 @@
+package artist
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Slug is a value object holding the URL-safe identifier of an artist.
+// It is derived from the artist name, so spelling variants that differ only
+// in case or punctuation ("AC/DC", "ac-dc") share a slug.
+type Slug struct {
+	value string
+}
+
+// NewSlug derives a Slug from an artist name.
+// Letters and digits are lower-cased; every other run of characters becomes a single dash.
+func NewSlug(name string) Slug {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return Slug{value: b.String()}
+}
+
+// ParseSlug validates a slug received from a client.
+func ParseSlug(value string) (Slug, error) {
+	slug := NewSlug(value)
+	if slug.IsEmpty() || slug.value != value {
+		return Slug{}, ErrInvalidSlug
+	}
+	return slug, nil
+}
+
+// String returns the string representation of the Slug.
+func (s Slug) String() string {
+	return s.value
+}
+
+// IsEmpty reports whether the slug is empty.
+func (s Slug) IsEmpty() bool {
+	return s.value == ""
+}
+
+// Equals checks if two slugs are equal.
+func (s Slug) Equals(other Slug) bool {
+	return s.value == other.value
+}
@@ -1,9 +1,14 @@
 package listener
 
-import "hub/internal/domain/shared"
+import (
+	"time"
+
+	"hub/internal/domain/shared"
+)
 
 const (
-	EventListenerTracked = "listener.tracked"
+	EventListenerTracked  = "listener.tracked"
+	EventListenersCounted = "listener.counted"
 )
 
 // ListenerTrackedEvent is emitted when a listener is tracked.
@@ -39,3 +44,34 @@ func (e *ListenerTrackedEvent) UserID() string {
 func (e *ListenerTrackedEvent) TrackID() string {
 	return e.trackID
 }
+
+// ListenersCounted is emitted when the listeners of a station are counted.
+type ListenersCounted struct {
+	shared.BaseEvent
+	count int
+}
+
+// NewListenersCounted creates a new ListenersCounted event.
+func NewListenersCounted(count int) ListenersCounted {
+	return ListenersCounted{
+		BaseEvent: shared.NewBaseEvent(EventListenersCounted),
+		count:     count,
+	}
+}
+
+// ReconstructListenersCounted recreates a ListenersCounted event from storage.
+func ReconstructListenersCounted(count int, occurredAt time.Time) ListenersCounted {
+	e := NewListenersCounted(count)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventListenersCounted, occurredAt)
+	return e
+}
+
+// Payload returns the event payload.
+func (e ListenersCounted) Payload() interface{} {
+	return map[string]interface{}{
+		"listeners": e.count,
+	}
+}
+
+// Count returns the number of listeners.
+func (e ListenersCounted) Count() int { return e.count }
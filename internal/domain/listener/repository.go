@@ -1,6 +1,9 @@
 package listener
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository defines the listener repository interface.
 type Repository interface {
@@ -13,3 +16,21 @@ type Repository interface {
 	// CountByTrack returns the unique listener count for a track.
 	CountByTrack(ctx context.Context, trackID string) (int, error)
 }
+
+// SessionRepository defines the listener session repository interface.
+type SessionRepository interface {
+	// FindOpen returns every session that has not ended.
+	FindOpen(ctx context.Context) ([]*Session, error)
+
+	// Open persists a new session and the track heard, if any. It does
+	// nothing if the listener already has an open session on the station,
+	// as when another replica opened it concurrently.
+	Open(ctx context.Context, session *Session) error
+
+	// Extend marks the sessions as seen at seenAt and records the track
+	// heard, if any, as their last one.
+	Extend(ctx context.Context, ids []int64, seenAt time.Time, trackID string) error
+
+	// Close persists the end and duration of a session.
+	Close(ctx context.Context, session *Session) error
+}
@@ -0,0 +1,155 @@
+package listener
+
+import "time"
+
+const (
+	// SessionGap is how long a listener may go unseen before their session
+	// is considered over, e.g. when polling stopped for a while.
+	SessionGap = time.Minute
+
+	// tuneInWindow is how recently a listener must have connected for the
+	// session to count as tuning in to the track on air. Listeners found
+	// already connected for longer were missed by earlier polls.
+	tuneInWindow = 10 * time.Second
+
+	// reconnectSlack absorbs clock and polling jitter when deciding whether
+	// a client ID still belongs to the same connection.
+	reconnectSlack = 30 * time.Second
+)
+
+// Session is one continuous connection of a listener to the stream,
+// reconstructed from successive polls of the Icecast client list.
+type Session struct {
+	id           int64
+	userID       string
+	clientID     int
+	startedAt    time.Time
+	lastSeenAt   time.Time
+	endedAt      *time.Time
+	firstTrackID string
+	lastTrackID  string
+}
+
+// NewSession opens a session for a client first seen at seenAt, connected
+// since connectedAt. The track on air is recorded as tuned in to only if
+// the client connected within the last poll or so.
+func NewSession(userID string, clientID int, connectedAt, seenAt time.Time, trackID string) (*Session, error) {
+	if userID == "" {
+		return nil, ErrInvalidUserID
+	}
+	if connectedAt.After(seenAt) {
+		connectedAt = seenAt
+	}
+
+	s := &Session{
+		userID:      userID,
+		clientID:    clientID,
+		startedAt:   connectedAt,
+		lastSeenAt:  seenAt,
+		lastTrackID: trackID,
+	}
+	if seenAt.Sub(connectedAt) <= tuneInWindow {
+		s.firstTrackID = trackID
+	}
+	return s, nil
+}
+
+// ReconstructSession rebuilds a Session from persistence.
+func ReconstructSession(id int64, userID string, clientID int, startedAt, lastSeenAt time.Time, endedAt *time.Time, firstTrackID, lastTrackID string) *Session {
+	return &Session{
+		id:           id,
+		userID:       userID,
+		clientID:     clientID,
+		startedAt:    startedAt,
+		lastSeenAt:   lastSeenAt,
+		endedAt:      endedAt,
+		firstTrackID: firstTrackID,
+		lastTrackID:  lastTrackID,
+	}
+}
+
+// ContinuesWith reports whether a client seen at seenAt, connected since
+// connectedAt, is the same connection as this session. It is not if the
+// session went unseen for longer than SessionGap, or if the client
+// connected after the session started, meaning Icecast reused the ID.
+func (s *Session) ContinuesWith(connectedAt, seenAt time.Time) bool {
+	if s.IsClosed() {
+		return false
+	}
+	if seenAt.Sub(s.lastSeenAt) > SessionGap {
+		return false
+	}
+	return !connectedAt.After(s.startedAt.Add(reconnectSlack))
+}
+
+// Extend records that the listener was still connected at seenAt.
+func (s *Session) Extend(seenAt time.Time, trackID string) {
+	if seenAt.After(s.lastSeenAt) {
+		s.lastSeenAt = seenAt
+	}
+	if trackID != "" {
+		s.lastTrackID = trackID
+	}
+}
+
+// Close ends the session when the listener was last seen.
+func (s *Session) Close() {
+	if s.IsClosed() {
+		return
+	}
+	endedAt := s.lastSeenAt
+	s.endedAt = &endedAt
+}
+
+// IsClosed returns true if the session has ended.
+func (s *Session) IsClosed() bool {
+	return s.endedAt != nil
+}
+
+// Duration returns how long the listener has been, or was, connected.
+func (s *Session) Duration() time.Duration {
+	if s.endedAt != nil {
+		return s.endedAt.Sub(s.startedAt)
+	}
+	return s.lastSeenAt.Sub(s.startedAt)
+}
+
+// ID returns the session ID, zero until persisted.
+func (s *Session) ID() int64 {
+	return s.id
+}
+
+// UserID returns the anonymous listener ID.
+func (s *Session) UserID() string {
+	return s.userID
+}
+
+// ClientID returns the Icecast client ID.
+func (s *Session) ClientID() int {
+	return s.clientID
+}
+
+// StartedAt returns when the listener connected.
+func (s *Session) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// LastSeenAt returns when the listener was last seen connected.
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
+
+// EndedAt returns when the session ended, or nil while open.
+func (s *Session) EndedAt() *time.Time {
+	return s.endedAt
+}
+
+// FirstTrackID returns the track the listener tuned in to, if known.
+func (s *Session) FirstTrackID() string {
+	return s.firstTrackID
+}
+
+// LastTrackID returns the last track heard in the session.
+func (s *Session) LastTrackID() string {
+	return s.lastTrackID
+}
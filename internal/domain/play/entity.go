@@ -0,0 +1,128 @@
+package play
+
+import (
+	"time"
+
+	"hub/internal/domain/track"
+
+	"github.com/google/uuid"
+)
+
+// Play represents a single rotation of a track on air.
+// A play is open until the next play starts.
+type Play struct {
+	id             string
+	trackID        track.TrackID
+	startedAt      time.Time
+	endedAt        *time.Time
+	listenersStart int
+	listenersEnd   *int
+
+	// Listener movements, known once the play has ended
+	listenersPresent  *int
+	listenersJoined   *int
+	listenersTunedOut *int
+}
+
+// NewPlay creates a new open Play entity.
+func NewPlay(trackID track.TrackID, listeners int, startedAt time.Time) *Play {
+	return &Play{
+		id:             uuid.New().String(),
+		trackID:        trackID,
+		startedAt:      startedAt,
+		listenersStart: listeners,
+	}
+}
+
+// ReconstructPlay rebuilds a Play from persistence data.
+func ReconstructPlay(
+	id, trackID string,
+	startedAt time.Time,
+	endedAt *time.Time,
+	listenersStart int,
+	listenersEnd *int,
+	listenersPresent *int,
+	listenersJoined *int,
+	listenersTunedOut *int,
+) (*Play, error) {
+	tid, err := track.NewTrackID(trackID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Play{
+		id:             id,
+		trackID:        tid,
+		startedAt:      startedAt,
+		endedAt:        endedAt,
+		listenersStart: listenersStart,
+		listenersEnd:   listenersEnd,
+
+		listenersPresent:  listenersPresent,
+		listenersJoined:   listenersJoined,
+		listenersTunedOut: listenersTunedOut,
+	}, nil
+}
+
+// End closes the play with the listener count at the given time.
+// Returns ErrPlayAlreadyEnded if the play is already closed.
+func (p *Play) End(listeners int, at time.Time) error {
+	if p.endedAt != nil {
+		return ErrPlayAlreadyEnded
+	}
+	if at.Before(p.startedAt) {
+		at = p.startedAt
+	}
+
+	p.endedAt = &at
+	p.listenersEnd = &listeners
+	return nil
+}
+
+// RecordAudience sets how many listeners were present when the play started,
+// how many joined during it and how many of those present tuned out early
+// in it. Returns ErrPlayNotEnded while the play is on air.
+func (p *Play) RecordAudience(present, joined, tunedOut int) error {
+	if p.endedAt == nil {
+		return ErrPlayNotEnded
+	}
+
+	p.listenersPresent = &present
+	p.listenersJoined = &joined
+	p.listenersTunedOut = &tunedOut
+	return nil
+}
+
+// Getters
+
+// ID returns the play's unique identifier.
+func (p *Play) ID() string { return p.id }
+
+// TrackID returns the played track's ID.
+func (p *Play) TrackID() track.TrackID { return p.trackID }
+
+// StartedAt returns when the play started.
+func (p *Play) StartedAt() time.Time { return p.startedAt }
+
+// EndedAt returns when the play ended, or nil if it is still on air.
+func (p *Play) EndedAt() *time.Time { return p.endedAt }
+
+// ListenersStart returns the listener count when the play started.
+func (p *Play) ListenersStart() int { return p.listenersStart }
+
+// ListenersEnd returns the listener count when the play ended, or nil if it is still on air.
+func (p *Play) ListenersEnd() *int { return p.listenersEnd }
+
+// ListenersPresent returns how many listener sessions were open when the
+// play started, or nil if unknown.
+func (p *Play) ListenersPresent() *int { return p.listenersPresent }
+
+// ListenersJoined returns how many listeners joined during the play, or nil if unknown.
+func (p *Play) ListenersJoined() *int { return p.listenersJoined }
+
+// ListenersTunedOut returns how many of the listeners present tuned out early
+// in the play, or nil if unknown.
+func (p *Play) ListenersTunedOut() *int { return p.listenersTunedOut }
+
+// IsOpen returns true if the play is still on air.
+func (p *Play) IsOpen() bool { return p.endedAt == nil }
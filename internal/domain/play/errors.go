@@ -0,0 +1,28 @@
+package play
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// Domain errors for play operations.
+var (
+	ErrPlayNotFound = shared.NewDomainError(
+		shared.ErrNotFound,
+		"play not found",
+	)
+
+	ErrPlayAlreadyEnded = shared.NewDomainError(
+		shared.ErrOperationFailed,
+		"play has already ended",
+	)
+
+	ErrPlayNotEnded = shared.NewDomainError(
+		shared.ErrOperationFailed,
+		"play has not ended yet",
+	)
+
+	ErrInvalidTimeRange = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"'from' must be before 'to'",
+	)
+)
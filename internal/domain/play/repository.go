@@ -0,0 +1,17 @@
+package play
+
+import "context"
+
+// Repository defines the interface for play persistence.
+type Repository interface {
+	// Save persists a play, inserting it or updating its end data.
+	Save(ctx context.Context, play *Play) error
+
+	// FindCurrent retrieves the play that is currently on air.
+	// Returns ErrPlayNotFound if no play is open.
+	FindCurrent(ctx context.Context) (*Play, error)
+
+	// FindCurrentForUpdate retrieves the play on air like FindCurrent and
+	// locks it until the transaction carried by ctx ends.
+	FindCurrentForUpdate(ctx context.Context) (*Play, error)
+}
@@ -0,0 +1,42 @@
+package reaction
+
+// Definition describes a configured reaction type: how clients render it and
+// whether it can currently be used.
+type Definition struct {
+	reactionType ReactionType
+	emoji        string
+	label        string
+	position     int
+	enabled      bool
+}
+
+// ReconstructDefinition rebuilds a Definition from persistence data.
+func ReconstructDefinition(key, emoji, label string, position int, enabled bool) (*Definition, error) {
+	rt, err := NewReactionType(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Definition{
+		reactionType: rt,
+		emoji:        emoji,
+		label:        label,
+		position:     position,
+		enabled:      enabled,
+	}, nil
+}
+
+// ReactionType returns the reaction type the definition configures.
+func (d *Definition) ReactionType() ReactionType { return d.reactionType }
+
+// Emoji returns the emoji shown for the reaction.
+func (d *Definition) Emoji() string { return d.emoji }
+
+// Label returns the human-readable name of the reaction.
+func (d *Definition) Label() string { return d.label }
+
+// Position returns the display order of the reaction.
+func (d *Definition) Position() int { return d.position }
+
+// Enabled returns true if users can currently react with this type.
+func (d *Definition) Enabled() bool { return d.enabled }
@@ -55,6 +55,17 @@ func ReconstructReaction(
 	}, nil
 }
 
+// ChangeType switches the reaction to the given type, dating it from now.
+// Returns false if the reaction already has that type.
+func (r *Reaction) ChangeType(reactionType ReactionType) bool {
+	if r.reactionType.Equals(reactionType) {
+		return false
+	}
+	r.reactionType = reactionType
+	r.createdAt = time.Now()
+	return true
+}
+
 // Getters
 
 // ID returns the reaction's database ID.
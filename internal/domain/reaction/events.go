@@ -1,10 +1,18 @@
 package reaction
 
 import (
+	"time"
+
 	"hub/internal/domain/shared"
 	"hub/internal/domain/track"
 )
 
+const (
+	EventReactionAdded   = "reaction.added"
+	EventReactionChanged = "reaction.changed"
+	EventReactionRemoved = "reaction.removed"
+)
+
 // ReactionAdded is emitted when a user reacts to a track.
 type ReactionAdded struct {
 	shared.BaseEvent
@@ -16,13 +24,20 @@ type ReactionAdded struct {
 // NewReactionAdded creates a new ReactionAdded event.
 func NewReactionAdded(userID UserID, trackID track.TrackID, reactionType ReactionType) ReactionAdded {
 	return ReactionAdded{
-		BaseEvent:    shared.NewBaseEvent("reaction.added"),
+		BaseEvent:    shared.NewBaseEvent(EventReactionAdded),
 		userID:       userID,
 		trackID:      trackID,
 		reactionType: reactionType,
 	}
 }
 
+// ReconstructReactionAdded recreates a ReactionAdded event from storage.
+func ReconstructReactionAdded(userID UserID, trackID track.TrackID, reactionType ReactionType, occurredAt time.Time) ReactionAdded {
+	e := NewReactionAdded(userID, trackID, reactionType)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventReactionAdded, occurredAt)
+	return e
+}
+
 // Payload returns the event data.
 func (e ReactionAdded) Payload() interface{} {
 	return map[string]interface{}{
@@ -40,3 +55,95 @@ func (e ReactionAdded) TrackID() track.TrackID { return e.trackID }
 
 // ReactionType returns the reaction type.
 func (e ReactionAdded) ReactionType() ReactionType { return e.reactionType }
+
+// ReactionChanged is emitted when a user switches their reaction to a track.
+type ReactionChanged struct {
+	shared.BaseEvent
+	userID  UserID
+	trackID track.TrackID
+	from    ReactionType
+	to      ReactionType
+}
+
+// NewReactionChanged creates a new ReactionChanged event.
+func NewReactionChanged(userID UserID, trackID track.TrackID, from, to ReactionType) ReactionChanged {
+	return ReactionChanged{
+		BaseEvent: shared.NewBaseEvent(EventReactionChanged),
+		userID:    userID,
+		trackID:   trackID,
+		from:      from,
+		to:        to,
+	}
+}
+
+// ReconstructReactionChanged recreates a ReactionChanged event from storage.
+func ReconstructReactionChanged(userID UserID, trackID track.TrackID, from, to ReactionType, occurredAt time.Time) ReactionChanged {
+	e := NewReactionChanged(userID, trackID, from, to)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventReactionChanged, occurredAt)
+	return e
+}
+
+// Payload returns the event data.
+func (e ReactionChanged) Payload() interface{} {
+	return map[string]interface{}{
+		"user_id":       e.userID.String(),
+		"track_id":      e.trackID.String(),
+		"from":          e.from.String(),
+		"reaction_type": e.to.String(),
+	}
+}
+
+// UserID returns the user ID.
+func (e ReactionChanged) UserID() UserID { return e.userID }
+
+// TrackID returns the track ID.
+func (e ReactionChanged) TrackID() track.TrackID { return e.trackID }
+
+// From returns the reaction type before the change.
+func (e ReactionChanged) From() ReactionType { return e.from }
+
+// ReactionType returns the reaction type after the change.
+func (e ReactionChanged) ReactionType() ReactionType { return e.to }
+
+// ReactionRemoved is emitted when a user retracts their reaction to a track.
+type ReactionRemoved struct {
+	shared.BaseEvent
+	userID       UserID
+	trackID      track.TrackID
+	reactionType ReactionType
+}
+
+// NewReactionRemoved creates a new ReactionRemoved event.
+func NewReactionRemoved(userID UserID, trackID track.TrackID, reactionType ReactionType) ReactionRemoved {
+	return ReactionRemoved{
+		BaseEvent:    shared.NewBaseEvent(EventReactionRemoved),
+		userID:       userID,
+		trackID:      trackID,
+		reactionType: reactionType,
+	}
+}
+
+// ReconstructReactionRemoved recreates a ReactionRemoved event from storage.
+func ReconstructReactionRemoved(userID UserID, trackID track.TrackID, reactionType ReactionType, occurredAt time.Time) ReactionRemoved {
+	e := NewReactionRemoved(userID, trackID, reactionType)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventReactionRemoved, occurredAt)
+	return e
+}
+
+// Payload returns the event data.
+func (e ReactionRemoved) Payload() interface{} {
+	return map[string]interface{}{
+		"user_id":       e.userID.String(),
+		"track_id":      e.trackID.String(),
+		"reaction_type": e.reactionType.String(),
+	}
+}
+
+// UserID returns the user ID.
+func (e ReactionRemoved) UserID() UserID { return e.userID }
+
+// TrackID returns the track ID.
+func (e ReactionRemoved) TrackID() track.TrackID { return e.trackID }
+
+// ReactionType returns the retracted reaction type.
+func (e ReactionRemoved) ReactionType() ReactionType { return e.reactionType }
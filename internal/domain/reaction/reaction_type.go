@@ -1,37 +1,39 @@
 package reaction
 
 import (
+	"regexp"
+
 	"hub/internal/domain/shared"
 )
 
-// ErrInvalidReactionType is returned when a reaction type is invalid.
+// ErrInvalidReactionType is returned when a reaction type is malformed or not configured.
 var ErrInvalidReactionType = shared.NewDomainError(
 	shared.ErrInvalidInput,
-	"reaction type must be 'like' or 'dislike'",
+	"reaction type is not a configured reaction",
 )
 
+// reactionTypeKey matches the keys stored in reaction_types.
+var reactionTypeKey = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
 // ReactionType is a value object representing the type of reaction.
+// Types are configured as data (see Definition); NewReactionType only checks the key format.
 type ReactionType struct {
 	value string
 }
 
-// Predefined reaction types.
+// Built-in reaction types. They also drive the likes/dislikes counters on tracks.
 var (
 	Like    = ReactionType{value: "like"}
 	Dislike = ReactionType{value: "dislike"}
 )
 
 // NewReactionType creates a new ReactionType from a string.
-// Returns an error if the string is not "like" or "dislike".
+// Returns an error if the string is not a lowercase key of up to 32 letters, digits or underscores.
 func NewReactionType(value string) (ReactionType, error) {
-	switch value {
-	case "like":
-		return Like, nil
-	case "dislike":
-		return Dislike, nil
-	default:
+	if !reactionTypeKey.MatchString(value) {
 		return ReactionType{}, ErrInvalidReactionType
 	}
+	return ReactionType{value: value}, nil
 }
 
 // String returns the string representation of the ReactionType.
@@ -41,12 +43,12 @@ func (rt ReactionType) String() string {
 
 // IsLike returns true if the reaction is a like.
 func (rt ReactionType) IsLike() bool {
-	return rt.value == "like"
+	return rt.value == Like.value
 }
 
 // IsDislike returns true if the reaction is a dislike.
 func (rt ReactionType) IsDislike() bool {
-	return rt.value == "dislike"
+	return rt.value == Dislike.value
 }
 
 // Equals checks if two ReactionTypes are equal.
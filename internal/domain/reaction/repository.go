@@ -12,6 +12,15 @@ type Repository interface {
 	// Returns ErrReactionExists if the user has already reacted to the track.
 	Save(ctx context.Context, reaction *Reaction) error
 
+	// Update switches a stored reaction from the previous type to its current type,
+	// moving the track counter in the same transaction.
+	// Returns ErrReactionNotFound if the stored reaction no longer has the previous type.
+	Update(ctx context.Context, reaction *Reaction, previous ReactionType) error
+
+	// Delete removes a reaction and decrements the track counter in the same transaction.
+	// Returns ErrReactionNotFound if the reaction no longer exists.
+	Delete(ctx context.Context, reaction *Reaction) error
+
 	// FindByUserAndTrack retrieves a reaction by user and track.
 	// Returns nil if no reaction exists.
 	FindByUserAndTrack(ctx context.Context, userID UserID, trackID track.TrackID) (*Reaction, error)
@@ -19,3 +28,13 @@ type Repository interface {
 	// Exists checks if a reaction exists for the given user and track.
 	Exists(ctx context.Context, userID UserID, trackID track.TrackID) (bool, error)
 }
+
+// TypeRepository defines the interface for the configured reaction types.
+type TypeRepository interface {
+	// FindAll retrieves every reaction type in display order.
+	FindAll(ctx context.Context) ([]*Definition, error)
+
+	// FindByType retrieves the definition of a reaction type.
+	// Returns ErrInvalidReactionType if the type is not configured.
+	FindByType(ctx context.Context, reactionType ReactionType) (*Definition, error)
+}
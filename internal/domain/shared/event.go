@@ -23,6 +23,14 @@ func NewBaseEvent(name string) BaseEvent {
 	}
 }
 
+// ReconstructBaseEvent recreates a base event from persistence.
+func ReconstructBaseEvent(name string, occurredAt time.Time) BaseEvent {
+	return BaseEvent{
+		name:       name,
+		occurredAt: occurredAt,
+	}
+}
+
 // EventName returns the name of the event.
 func (e BaseEvent) EventName() string {
 	return e.name
@@ -0,0 +1,53 @@
+package station
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultID is the ID of the default station, which owns the data from
+	// before stations existed and serves the unprefixed routes.
+	DefaultID int64 = 1
+	// DefaultSlug is the slug of the default station.
+	DefaultSlug = "main"
+)
+
+// slugPattern keeps slugs usable in URLs and environment variable names.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Station is a radio channel with its own stream, tracks and listeners.
+type Station struct {
+	id   int64
+	slug string
+	name string
+}
+
+// NewStation creates a new Station. The name defaults to the slug.
+func NewStation(slug, name string) (*Station, error) {
+	if !slugPattern.MatchString(slug) {
+		return nil, ErrInvalidSlug
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = slug
+	}
+	return &Station{slug: slug, name: name}, nil
+}
+
+// ReconstructStation rebuilds a Station from persistence data.
+func ReconstructStation(id int64, slug, name string) *Station {
+	return &Station{id: id, slug: slug, name: name}
+}
+
+// ID returns the station ID, zero until persisted.
+func (s *Station) ID() int64 { return s.id }
+
+// Slug returns the URL identifier of the station.
+func (s *Station) Slug() string { return s.slug }
+
+// Name returns the display name of the station.
+func (s *Station) Name() string { return s.name }
+
+// IsDefault returns true for the default station.
+func (s *Station) IsDefault() bool { return s.id == DefaultID }
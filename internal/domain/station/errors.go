@@ -0,0 +1,17 @@
+package station
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// Domain errors for station operations.
+var (
+	ErrStationNotFound = shared.NewDomainError(
+		shared.ErrNotFound,
+		"station not found",
+	)
+	ErrInvalidSlug = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"station slug must be lowercase letters, digits and dashes",
+	)
+)
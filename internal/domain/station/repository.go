@@ -0,0 +1,10 @@
+package station
+
+import "context"
+
+// Repository defines the interface for station persistence.
+type Repository interface {
+	// Save creates the station, or renames the existing station with the
+	// same slug, and returns it as persisted.
+	Save(ctx context.Context, s *Station) (*Station, error)
+}
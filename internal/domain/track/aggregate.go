@@ -12,6 +12,7 @@ type Track struct {
 
 	id        TrackID
 	title     Title
+	name      Name
 	cover     Cover
 	rotate    int
 	likes     int
@@ -23,11 +24,12 @@ type Track struct {
 
 // NewTrack creates a new Track aggregate.
 // This is the only way to create a new track.
-func NewTrack(id TrackID, title Title, cover Cover) *Track {
+func NewTrack(id TrackID, title Title, name Name, cover Cover) *Track {
 	now := time.Now()
 	t := &Track{
 		id:        id,
 		title:     title,
+		name:      name,
 		cover:     cover,
 		rotate:    1,
 		likes:     0,
@@ -44,7 +46,9 @@ func NewTrack(id TrackID, title Title, cover Cover) *Track {
 // ReconstructTrack rebuilds a Track from persistence data.
 // No events are emitted during reconstruction.
 func ReconstructTrack(
-	id, title, cover string,
+	id, title, artist, song string,
+	featuring []string,
+	cover string,
 	rotate, likes, dislikes, listeners int,
 	createdAt, updatedAt time.Time,
 ) (*Track, error) {
@@ -61,6 +65,7 @@ func ReconstructTrack(
 	return &Track{
 		id:        trackID,
 		title:     trackTitle,
+		name:      NewName(artist, song, featuring),
 		cover:     NewCover(cover),
 		rotate:    rotate,
 		likes:     likes,
@@ -95,6 +100,18 @@ func (t *Track) UpdateCover(newCover Cover) bool {
 	return true
 }
 
+// UpdateName sets the parsed artist and song if they are not known yet.
+// Returns true if the name was updated.
+func (t *Track) UpdateName(name Name) bool {
+	if !t.name.IsEmpty() || name.IsEmpty() {
+		return false
+	}
+
+	t.name = name
+	t.updatedAt = time.Now()
+	return true
+}
+
 // RecordLike increments the like count.
 func (t *Track) RecordLike() {
 	t.likes++
@@ -121,6 +138,9 @@ func (t *Track) ID() TrackID { return t.id }
 // Title returns the track's title.
 func (t *Track) Title() Title { return t.title }
 
+// Name returns the track's parsed artist and song.
+func (t *Track) Name() Name { return t.name }
+
 // Cover returns the track's cover URL.
 func (t *Track) Cover() Cover { return t.cover }
 
@@ -0,0 +1,76 @@
+package track
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// ErrInvalidSortField is returned when a track sort field is not supported.
+var ErrInvalidSortField = shared.NewDomainError(
+	shared.ErrInvalidInput,
+	"sort must be one of rotate, likes, dislikes, listeners, created_at, updated_at",
+)
+
+// SortField is a value object naming the field tracks are ordered by.
+type SortField struct {
+	value string
+}
+
+// Supported sort fields.
+var (
+	SortByRotate    = SortField{value: "rotate"}
+	SortByLikes     = SortField{value: "likes"}
+	SortByDislikes  = SortField{value: "dislikes"}
+	SortByListeners = SortField{value: "listeners"}
+	SortByCreatedAt = SortField{value: "created_at"}
+	SortByUpdatedAt = SortField{value: "updated_at"}
+)
+
+// NewSortField creates a SortField from a string.
+// An empty string defaults to SortByCreatedAt.
+func NewSortField(value string) (SortField, error) {
+	switch value {
+	case "":
+		return SortByCreatedAt, nil
+	case "rotate":
+		return SortByRotate, nil
+	case "likes":
+		return SortByLikes, nil
+	case "dislikes":
+		return SortByDislikes, nil
+	case "listeners":
+		return SortByListeners, nil
+	case "created_at":
+		return SortByCreatedAt, nil
+	case "updated_at":
+		return SortByUpdatedAt, nil
+	default:
+		return SortField{}, ErrInvalidSortField
+	}
+}
+
+// String returns the string representation of the SortField.
+func (f SortField) String() string {
+	return f.value
+}
+
+// Position marks the last track of the previous page in keyset pagination.
+// Value is the sort field value of that track in its canonical text form.
+type Position struct {
+	Value string
+	ID    TrackID
+}
+
+// Criteria describes a track catalogue query.
+type Criteria struct {
+	// Search matches titles case-insensitively; empty matches all tracks.
+	Search string
+	// Artist restricts the listing to an artist slug; empty matches all artists.
+	Artist string
+
+	SortBy     SortField
+	Descending bool
+	Limit      int
+
+	// After continues the listing after the given position; nil starts from the first page.
+	After *Position
+}
@@ -1,9 +1,17 @@
 package track
 
 import (
+	"time"
+
 	"hub/internal/domain/shared"
 )
 
+const (
+	EventTrackCreated = "track.created"
+	EventTrackRotated = "track.rotated"
+	EventCoverUpdated = "track.cover_updated"
+)
+
 // TrackCreated is emitted when a new track is created.
 type TrackCreated struct {
 	shared.BaseEvent
@@ -15,13 +23,20 @@ type TrackCreated struct {
 // NewTrackCreated creates a new TrackCreated event.
 func NewTrackCreated(id TrackID, title Title, cover Cover) TrackCreated {
 	return TrackCreated{
-		BaseEvent: shared.NewBaseEvent("track.created"),
+		BaseEvent: shared.NewBaseEvent(EventTrackCreated),
 		trackID:   id,
 		title:     title,
 		cover:     cover,
 	}
 }
 
+// ReconstructTrackCreated recreates a TrackCreated event from storage.
+func ReconstructTrackCreated(id TrackID, title Title, cover Cover, occurredAt time.Time) TrackCreated {
+	e := NewTrackCreated(id, title, cover)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventTrackCreated, occurredAt)
+	return e
+}
+
 // Payload returns the event data.
 func (e TrackCreated) Payload() interface{} {
 	return map[string]interface{}{
@@ -50,12 +65,19 @@ type TrackRotated struct {
 // NewTrackRotated creates a new TrackRotated event.
 func NewTrackRotated(id TrackID, newRotate int) TrackRotated {
 	return TrackRotated{
-		BaseEvent: shared.NewBaseEvent("track.rotated"),
+		BaseEvent: shared.NewBaseEvent(EventTrackRotated),
 		trackID:   id,
 		newRotate: newRotate,
 	}
 }
 
+// ReconstructTrackRotated recreates a TrackRotated event from storage.
+func ReconstructTrackRotated(id TrackID, newRotate int, occurredAt time.Time) TrackRotated {
+	e := NewTrackRotated(id, newRotate)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventTrackRotated, occurredAt)
+	return e
+}
+
 // Payload returns the event data.
 func (e TrackRotated) Payload() interface{} {
 	return map[string]interface{}{
@@ -81,13 +103,20 @@ type CoverUpdated struct {
 // NewCoverUpdated creates a new CoverUpdated event.
 func NewCoverUpdated(id TrackID, oldCover, newCover Cover) CoverUpdated {
 	return CoverUpdated{
-		BaseEvent: shared.NewBaseEvent("track.cover_updated"),
+		BaseEvent: shared.NewBaseEvent(EventCoverUpdated),
 		trackID:   id,
 		oldCover:  oldCover,
 		newCover:  newCover,
 	}
 }
 
+// ReconstructCoverUpdated recreates a CoverUpdated event from storage.
+func ReconstructCoverUpdated(id TrackID, oldCover, newCover Cover, occurredAt time.Time) CoverUpdated {
+	e := NewCoverUpdated(id, oldCover, newCover)
+	e.BaseEvent = shared.ReconstructBaseEvent(EventCoverUpdated, occurredAt)
+	return e
+}
+
 // Payload returns the event data.
 func (e CoverUpdated) Payload() interface{} {
 	return map[string]interface{}{
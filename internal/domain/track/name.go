@@ -0,0 +1,68 @@
+package track
+
+import "strings"
+
+// Name is a value object holding the artist and song parsed from a stream title.
+// Featured artists are kept apart from the main artist and the song.
+type Name struct {
+	artist    string
+	song      string
+	featuring []string
+}
+
+// NewName creates a new Name. Values are trimmed; empty featured entries are dropped.
+func NewName(artist, song string, featuring []string) Name {
+	feat := make([]string, 0, len(featuring))
+	for _, f := range featuring {
+		if f = strings.TrimSpace(f); f != "" {
+			feat = append(feat, f)
+		}
+	}
+
+	return Name{
+		artist:    strings.TrimSpace(artist),
+		song:      strings.TrimSpace(song),
+		featuring: feat,
+	}
+}
+
+// Artist returns the main artist.
+func (n Name) Artist() string {
+	return n.artist
+}
+
+// Song returns the song title without featured credits.
+func (n Name) Song() string {
+	return n.song
+}
+
+// Featuring returns the featured artists.
+func (n Name) Featuring() []string {
+	return n.featuring
+}
+
+// IsEmpty returns true if neither artist nor song is known.
+func (n Name) IsEmpty() bool {
+	return n.artist == "" && n.song == ""
+}
+
+// String returns the display text "Artist - Song", or just the song if the artist is unknown.
+func (n Name) String() string {
+	if n.artist == "" {
+		return n.song
+	}
+	return n.artist + " - " + n.song
+}
+
+// Equals checks if two Names are equal.
+func (n Name) Equals(other Name) bool {
+	if n.artist != other.artist || n.song != other.song || len(n.featuring) != len(other.featuring) {
+		return false
+	}
+	for i := range n.featuring {
+		if n.featuring[i] != other.featuring[i] {
+			return false
+		}
+	}
+	return true
+}
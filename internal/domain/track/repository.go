@@ -12,9 +12,23 @@ type Repository interface {
 	// Returns ErrTrackNotFound if the track doesn't exist.
 	FindByID(ctx context.Context, id TrackID) (*Track, error)
 
+	// FindByIDForUpdate retrieves a track like FindByID and locks it until
+	// the transaction carried by ctx ends.
+	FindByIDForUpdate(ctx context.Context, id TrackID) (*Track, error)
+
 	// Exists checks if a track with the given ID exists.
 	Exists(ctx context.Context, id TrackID) (bool, error)
 
 	// UpdateListenerCount updates the listener count for a track.
 	UpdateListenerCount(ctx context.Context, id TrackID, count int) error
+
+	// UpdateName updates the parsed artist and song of a track.
+	UpdateName(ctx context.Context, id TrackID, name Name) error
+
+	// RefreshArtistSlug re-derives the stored artist slug of a track from
+	// its artist and reports whether it changed.
+	RefreshArtistSlug(ctx context.Context, id TrackID, name Name) (bool, error)
+
+	// FindByCriteria retrieves tracks matching the criteria in its sort order.
+	FindByCriteria(ctx context.Context, criteria Criteria) ([]*Track, error)
 }
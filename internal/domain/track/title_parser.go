@@ -0,0 +1,89 @@
+package track
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultTitleSeparators are the artist/song separators used when none are configured.
+var DefaultTitleSeparators = []string{" - ", " – ", " — "}
+
+var (
+	// trackIDSuffix matches the "[md5]" suffix appended to stream titles.
+	trackIDSuffix = regexp.MustCompile(`\s*\[[a-fA-F0-9]{32}\]\s*$`)
+
+	// bracketedFeat matches "(feat. X)" or "[ft. X]" credits in a song title.
+	bracketedFeat = regexp.MustCompile(`(?i)\s*[\(\[]\s*(?:feat\.?|ft\.?|featuring)\s+([^\)\]]+)[\)\]]`)
+
+	// inlineFeat matches an unbracketed "feat. X" credit up to the end of the string.
+	inlineFeat = regexp.MustCompile(`(?i)\s+(?:feat\.?|ft\.?|featuring)\s+(.+)$`)
+
+	// creditSeparator splits a list of featured artists.
+	creditSeparator = regexp.MustCompile(`\s*[,&]\s*`)
+)
+
+// TitleParser splits raw stream titles such as "Artist feat. Guest - Song [md5]"
+// into a Name.
+type TitleParser struct {
+	separators []string
+}
+
+// NewTitleParser creates a TitleParser that splits artist from song on the
+// first occurrence of any of the separators.
+func NewTitleParser(separators []string) *TitleParser {
+	seps := make([]string, 0, len(separators))
+	for _, s := range separators {
+		if s != "" {
+			seps = append(seps, s)
+		}
+	}
+	if len(seps) == 0 {
+		seps = DefaultTitleSeparators
+	}
+	return &TitleParser{separators: seps}
+}
+
+// Parse extracts artist, song and featured artists from a raw stream title.
+// A title without a separator is treated as a song by an unknown artist.
+func (p *TitleParser) Parse(raw string) Name {
+	title := strings.TrimSpace(trackIDSuffix.ReplaceAllString(raw, ""))
+
+	artist, song := "", title
+	if idx, sep := p.firstSeparator(title); idx >= 0 {
+		artist, song = title[:idx], title[idx+len(sep):]
+	}
+
+	featuring := make([]string, 0)
+
+	if m := inlineFeat.FindStringSubmatchIndex(artist); m != nil {
+		featuring = append(featuring, splitCredits(artist[m[2]:m[3]])...)
+		artist = artist[:m[0]]
+	}
+
+	for _, m := range bracketedFeat.FindAllStringSubmatch(song, -1) {
+		featuring = append(featuring, splitCredits(m[1])...)
+	}
+	song = bracketedFeat.ReplaceAllString(song, "")
+
+	if m := inlineFeat.FindStringSubmatchIndex(song); m != nil {
+		featuring = append(featuring, splitCredits(song[m[2]:m[3]])...)
+		song = song[:m[0]]
+	}
+
+	return NewName(artist, song, featuring)
+}
+
+// firstSeparator returns the position and value of the earliest separator in s, or -1.
+func (p *TitleParser) firstSeparator(s string) (int, string) {
+	idx, found := -1, ""
+	for _, sep := range p.separators {
+		if i := strings.Index(s, sep); i >= 0 && (idx < 0 || i < idx) {
+			idx, found = i, sep
+		}
+	}
+	return idx, found
+}
+
+func splitCredits(s string) []string {
+	return creditSeparator.Split(strings.TrimSpace(s), -1)
+}
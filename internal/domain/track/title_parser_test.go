@@ -0,0 +1,104 @@
+package track_test
+
+import (
+	"slices"
+	"testing"
+
+	"hub/internal/domain/track"
+)
+
+func TestTitleParserParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		separators []string
+		raw        string
+		artist     string
+		song       string
+		featuring  []string
+	}{
+		{
+			name:   "hyphen separator",
+			raw:    "Artist - Song",
+			artist: "Artist",
+			song:   "Song",
+		},
+		{
+			name: "no separator",
+			raw:  "Just A Song",
+			song: "Just A Song",
+		},
+		{
+			name:   "en dash separator",
+			raw:    "Artist – Song",
+			artist: "Artist",
+			song:   "Song",
+		},
+		{
+			name:   "em dash separator",
+			raw:    "Artist — Song",
+			artist: "Artist",
+			song:   "Song",
+		},
+		{
+			name:   "earliest separator wins",
+			raw:    "Artist — Song - Remix",
+			artist: "Artist",
+			song:   "Song - Remix",
+		},
+		{
+			name:      "bracketed featuring list",
+			raw:       "Artist - Song (feat. A & B)",
+			artist:    "Artist",
+			song:      "Song",
+			featuring: []string{"A", "B"},
+		},
+		{
+			name:      "inline featuring on the artist",
+			raw:       "Artist ft. Guest - Song",
+			artist:    "Artist",
+			song:      "Song",
+			featuring: []string{"Guest"},
+		},
+		{
+			name:   "track ID suffix",
+			raw:    "Artist - Song [0123456789abcdef0123456789ABCDEF]",
+			artist: "Artist",
+			song:   "Song",
+		},
+		{
+			name: "track ID suffix without a separator",
+			raw:  "Just A Song [0123456789abcdef0123456789abcdef]",
+			song: "Just A Song",
+		},
+		{
+			name:       "empty separators fall back to the defaults",
+			separators: []string{"", ""},
+			raw:        "Artist – Song",
+			artist:     "Artist",
+			song:       "Song",
+		},
+		{
+			name:       "configured separator",
+			separators: []string{" / "},
+			raw:        "Artist / Song - Remix",
+			artist:     "Artist",
+			song:       "Song - Remix",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := track.NewTitleParser(tt.separators).Parse(tt.raw)
+
+			if got.Artist() != tt.artist {
+				t.Errorf("artist = %q, want %q", got.Artist(), tt.artist)
+			}
+			if got.Song() != tt.song {
+				t.Errorf("song = %q, want %q", got.Song(), tt.song)
+			}
+			if !slices.Equal(got.Featuring(), tt.featuring) {
+				t.Errorf("featuring = %q, want %q", got.Featuring(), tt.featuring)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"time"
+)
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	StatusPending   DeliveryStatus = "pending"
+	StatusSucceeded DeliveryStatus = "succeeded"
+	StatusDead      DeliveryStatus = "dead"
+)
+
+// NewDeliveryStatus creates a DeliveryStatus from a string.
+func NewDeliveryStatus(value string) (DeliveryStatus, error) {
+	switch s := DeliveryStatus(value); s {
+	case StatusPending, StatusSucceeded, StatusDead:
+		return s, nil
+	default:
+		return "", ErrInvalidDeliveryStatus
+	}
+}
+
+// String returns the string representation of the status.
+func (s DeliveryStatus) String() string { return string(s) }
+
+// Delivery is one event posted, or to be posted, to a webhook.
+// It doubles as the delivery log: attempts, the last response and error are kept.
+type Delivery struct {
+	id             int64
+	webhookID      string
+	eventID        string
+	eventName      string
+	station        string
+	payload        []byte
+	status         DeliveryStatus
+	attempts       int
+	responseStatus *int
+	lastError      string
+	nextAttemptAt  time.Time
+	createdAt      time.Time
+	deliveredAt    *time.Time
+}
+
+// NewDelivery creates a pending delivery of an event payload to a webhook.
+// The event ID, if known, makes enqueueing the same event twice a no-op.
+// station is the slug of the station the event happened on.
+func NewDelivery(webhookID, eventID, eventName, station string, payload []byte) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		webhookID:     webhookID,
+		eventID:       eventID,
+		eventName:     eventName,
+		station:       station,
+		payload:       payload,
+		status:        StatusPending,
+		nextAttemptAt: now,
+		createdAt:     now,
+	}
+}
+
+// ReconstructDelivery rebuilds a Delivery from persistence data.
+func ReconstructDelivery(
+	id int64,
+	webhookID, eventID, eventName, station string,
+	payload []byte,
+	status DeliveryStatus,
+	attempts int,
+	responseStatus *int,
+	lastError string,
+	nextAttemptAt, createdAt time.Time,
+	deliveredAt *time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		webhookID:      webhookID,
+		eventID:        eventID,
+		eventName:      eventName,
+		station:        station,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		responseStatus: responseStatus,
+		lastError:      lastError,
+		nextAttemptAt:  nextAttemptAt,
+		createdAt:      createdAt,
+		deliveredAt:    deliveredAt,
+	}
+}
+
+// Getters
+
+// ID returns the delivery ID.
+func (d *Delivery) ID() int64 { return d.id }
+
+// WebhookID returns the ID of the target webhook.
+func (d *Delivery) WebhookID() string { return d.webhookID }
+
+// EventID returns the ID of the delivered event, or "" if unknown.
+func (d *Delivery) EventID() string { return d.eventID }
+
+// EventName returns the delivered event name.
+func (d *Delivery) EventName() string { return d.eventName }
+
+// Station returns the slug of the station the event happened on.
+func (d *Delivery) Station() string { return d.station }
+
+// Payload returns the JSON body posted to the webhook.
+func (d *Delivery) Payload() []byte { return d.payload }
+
+// Status returns the delivery status.
+func (d *Delivery) Status() DeliveryStatus { return d.status }
+
+// Attempts returns how many times delivery was attempted.
+func (d *Delivery) Attempts() int { return d.attempts }
+
+// ResponseStatus returns the HTTP status of the last attempt, or nil if none was received.
+func (d *Delivery) ResponseStatus() *int { return d.responseStatus }
+
+// LastError returns the error of the last failed attempt.
+func (d *Delivery) LastError() string { return d.lastError }
+
+// NextAttemptAt returns when the delivery is next attempted.
+func (d *Delivery) NextAttemptAt() time.Time { return d.nextAttemptAt }
+
+// CreatedAt returns when the delivery was created.
+func (d *Delivery) CreatedAt() time.Time { return d.createdAt }
+
+// DeliveredAt returns when the delivery succeeded, or nil.
+func (d *Delivery) DeliveredAt() *time.Time { return d.deliveredAt }
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// secretPrefix marks webhook signing secrets so they are recognisable.
+const secretPrefix = "whsec_"
+
+// Webhook is a subscription of an external URL to domain events, of one
+// station or of every station. Deliveries are signed with the webhook's secret.
+type Webhook struct {
+	id        string
+	url       string
+	secret    string
+	events    []string
+	stationID int64
+	createdAt time.Time
+}
+
+// NewWebhook creates a new Webhook with a generated signing secret.
+// A station ID of 0 subscribes to the events of every station.
+func NewWebhook(rawURL string, events []string, stationID int64) (*Webhook, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	if len(events) == 0 {
+		return nil, ErrNoEvents
+	}
+	for _, e := range events {
+		if !isKnownEvent(e) {
+			return nil, ErrUnknownEvent
+		}
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+
+	return &Webhook{
+		id:        uuid.New().String(),
+		url:       u.String(),
+		secret:    secretPrefix + base64.RawURLEncoding.EncodeToString(raw),
+		events:    events,
+		stationID: stationID,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// ReconstructWebhook rebuilds a Webhook from persistence data.
+func ReconstructWebhook(id, url, secret string, events []string, stationID int64, createdAt time.Time) *Webhook {
+	return &Webhook{
+		id:        id,
+		url:       url,
+		secret:    secret,
+		events:    events,
+		stationID: stationID,
+		createdAt: createdAt,
+	}
+}
+
+// Matches returns true if the webhook subscribes to the event.
+func (w *Webhook) Matches(eventName string) bool {
+	for _, e := range w.events {
+		if e == AllEvents || e == eventName {
+			return true
+		}
+	}
+	return false
+}
+
+// Getters
+
+// ID returns the webhook ID.
+func (w *Webhook) ID() string { return w.id }
+
+// URL returns the URL deliveries are posted to.
+func (w *Webhook) URL() string { return w.url }
+
+// Secret returns the signing secret.
+func (w *Webhook) Secret() string { return w.secret }
+
+// Events returns the subscribed event names.
+func (w *Webhook) Events() []string { return w.events }
+
+// StationID returns the station the webhook subscribes to, or 0 for every station.
+func (w *Webhook) StationID() int64 { return w.stationID }
+
+// CreatedAt returns when the webhook was created.
+func (w *Webhook) CreatedAt() time.Time { return w.createdAt }
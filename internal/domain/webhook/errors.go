@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"hub/internal/domain/shared"
+)
+
+// Domain errors for webhook operations.
+var (
+	ErrWebhookNotFound = shared.NewDomainError(
+		shared.ErrNotFound,
+		"webhook not found",
+	)
+	ErrInvalidURL = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"webhook URL must be an absolute http or https URL",
+	)
+	ErrNoEvents = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"webhook needs at least one event",
+	)
+	ErrUnknownEvent = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"unknown webhook event",
+	)
+	ErrInvalidDeliveryStatus = shared.NewDomainError(
+		shared.ErrInvalidInput,
+		"invalid delivery status",
+	)
+)
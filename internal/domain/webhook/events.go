@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"hub/internal/domain/reaction"
+	"hub/internal/domain/track"
+)
+
+// AllEvents subscribes a webhook to every event.
+const AllEvents = "*"
+
+// Events lists the domain events webhooks can subscribe to.
+var Events = []string{
+	track.EventTrackCreated,
+	track.EventTrackRotated,
+	track.EventCoverUpdated,
+	reaction.EventReactionAdded,
+	reaction.EventReactionChanged,
+	reaction.EventReactionRemoved,
+}
+
+// isKnownEvent returns true if webhooks can subscribe to the event.
+func isKnownEvent(name string) bool {
+	if name == AllEvents {
+		return true
+	}
+	for _, e := range Events {
+		if e == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for webhook persistence.
+type Repository interface {
+	// Save persists a webhook.
+	Save(ctx context.Context, w *Webhook) error
+
+	// FindByID retrieves a webhook by its ID.
+	// Returns ErrWebhookNotFound if the webhook doesn't exist.
+	FindByID(ctx context.Context, id string) (*Webhook, error)
+
+	// FindAll retrieves every webhook, newest first.
+	FindAll(ctx context.Context) ([]*Webhook, error)
+
+	// FindByEvent retrieves the webhooks subscribed to an event of the
+	// station the context is scoped to.
+	FindByEvent(ctx context.Context, eventName string) ([]*Webhook, error)
+
+	// Delete removes a webhook and its deliveries.
+	// Returns ErrWebhookNotFound if the webhook doesn't exist.
+	Delete(ctx context.Context, id string) error
+}
+
+// DeliveryCriteria filters the delivery log of a webhook.
+type DeliveryCriteria struct {
+	Status *DeliveryStatus
+	Limit  int
+}
+
+// DeliveryRepository defines the interface for webhook delivery persistence.
+type DeliveryRepository interface {
+	// Enqueue stores pending deliveries, skipping those of an event
+	// already enqueued for the webhook.
+	Enqueue(ctx context.Context, deliveries []*Delivery) error
+
+	// FindByWebhook retrieves a webhook's deliveries, newest first.
+	FindByWebhook(ctx context.Context, webhookID string, criteria DeliveryCriteria) ([]*Delivery, error)
+
+	// Claim leases up to limit pending deliveries that are due, so concurrent
+	// workers don't pick them up again until the lease expires.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+
+	// MarkSucceeded records a successful attempt.
+	MarkSucceeded(ctx context.Context, id int64, responseStatus int) error
+
+	// MarkFailed records a failed attempt. responseStatus is 0 when no response
+	// was received. The delivery is retried at nextAttempt, or dead-lettered when dead is set.
+	MarkFailed(ctx context.Context, id int64, responseStatus int, cause string, nextAttempt time.Time, dead bool) error
+}
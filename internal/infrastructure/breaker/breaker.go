@@ -0,0 +1,142 @@
+// Package breaker stops calling a dependency that keeps failing.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned instead of calling a dependency whose breaker is open.
+var ErrOpen = errors.New("circuit breaker open")
+
+// State is the state of a breaker.
+type State int
+
+const (
+	// Closed lets every call through.
+	Closed State = iota
+	// HalfOpen lets a single probe through once the cooldown has passed.
+	HalfOpen
+	// Open rejects calls until the cooldown has passed.
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker opens after a number of consecutive failures and rejects calls
+// for a cooldown, then lets one probe through whose outcome closes it or
+// opens it again.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+	onChange  func(State)
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// New creates a closed breaker opening after threshold consecutive
+// failures; a threshold below 1 disables it. onChange, if set, is called
+// on every state change.
+func New(name string, threshold int, cooldown time.Duration, onChange func(State)) *Breaker {
+	return &Breaker{
+		name:      name,
+		threshold: threshold,
+		cooldown:  cooldown,
+		onChange:  onChange,
+	}
+}
+
+// Name returns the name of the guarded dependency.
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may go through, returning ErrOpen if not.
+// Every allowed call must be followed by Record.
+func (b *Breaker) Allow() error {
+	if b.threshold < 1 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if time.Since(b.openedAt) < b.cooldown {
+			return ErrOpen
+		}
+		b.setState(HalfOpen)
+		b.probing = true
+		return nil
+	case HalfOpen:
+		if b.probing {
+			return ErrOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record reports the outcome of an allowed call. A call canceled by its
+// caller says nothing about the dependency and only frees the probe.
+func (b *Breaker) Record(err error) {
+	if b.threshold < 1 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasProbe := b.probing
+	b.probing = false
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return
+	case err == nil:
+		b.failures = 0
+		b.setState(Closed)
+	default:
+		b.failures++
+		if wasProbe || b.failures >= b.threshold {
+			b.openedAt = time.Now()
+			b.setState(Open)
+		}
+	}
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"hub/internal/config"
+	"hub/internal/infrastructure/metrics"
 	"hub/internal/logger"
 
 	"github.com/redis/go-redis/v9"
@@ -28,13 +30,14 @@ type (
 	}
 
 	cache struct {
-		client *redis.Client
-		prefix string
-		logger *logger.Logger
+		client  *redis.Client
+		prefix  string
+		logger  *logger.Logger
+		metrics *metrics.Metrics
 	}
 )
 
-func NewCache(cfg config.Config, log *logger.Logger) (Cache, error) {
+func NewCache(cfg config.Config, log *logger.Logger, m *metrics.Metrics) (Cache, error) {
 	url, prefix := cfg.RedisConnection()
 
 	options, err := redis.ParseURL(url)
@@ -55,9 +58,10 @@ func NewCache(cfg config.Config, log *logger.Logger) (Cache, error) {
 	log.Info("Redis cache connected")
 
 	return &cache{
-		client: client,
-		prefix: prefix,
-		logger: log,
+		client:  client,
+		prefix:  prefix,
+		logger:  log,
+		metrics: m,
 	}, nil
 }
 
@@ -67,10 +71,12 @@ func (c *cache) Get(ctx context.Context, key string, dest interface{}) error {
 	data, err := c.client.Get(ctx, fullKey).Bytes()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
+			c.metrics.RecordCacheMiss(keyGroup(key))
 			return ErrCacheMiss
 		}
 		return err
 	}
+	c.metrics.RecordCacheHit(keyGroup(key))
 
 	return json.Unmarshal(data, dest)
 }
@@ -107,3 +113,12 @@ func (c *cache) Client() *redis.Client {
 func (c *cache) prefixKey(key string) string {
 	return fmt.Sprintf("%s%s", c.prefix, key)
 }
+
+// keyGroup returns the namespace of a key, e.g. "stats" for "stats:top:5",
+// so metrics are labelled per kind of entry rather than per entry.
+func keyGroup(key string) string {
+	if i := strings.IndexByte(key, ':'); i > 0 {
+		return key[:i]
+	}
+	return key
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	appshared "hub/internal/application/shared"
@@ -50,6 +51,22 @@ func (p *InMemoryPublisher) Publish(ctx context.Context, event shared.DomainEven
 	return nil
 }
 
+// Dispatch runs the handlers registered for the event synchronously and
+// returns their combined error, for callers that need to know about failures.
+func (p *InMemoryPublisher) Dispatch(ctx context.Context, event shared.DomainEvent) error {
+	p.mu.RLock()
+	handlers := p.handlers[event.EventName()]
+	p.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // PublishAll publishes multiple domain events.
 func (p *InMemoryPublisher) PublishAll(ctx context.Context, events []shared.DomainEvent) error {
 	for _, event := range events {
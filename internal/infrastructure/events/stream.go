@@ -0,0 +1,468 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/station"
+	"hub/internal/infrastructure/outbox"
+	"hub/internal/logger"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	envelopeField = "envelope"
+
+	readCount = 100
+	readBlock = 5 * time.Second
+
+	// claimIdle is how long an entry may stay unacknowledged before another
+	// consumer of the group reclaims it. Handlers get the same budget.
+	claimIdle       = 30 * time.Second
+	reclaimInterval = 15 * time.Second
+
+	// maxDeliveries dead-letters entries whose handlers keep failing.
+	maxDeliveries = 10
+
+	// maxLen caps the stream, trimmed approximately on every append.
+	maxLen = 100000
+
+	retryDelay = time.Second
+)
+
+// Envelope is the JSON form of a domain event on the stream.
+type Envelope struct {
+	Name          string          `json:"name"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	StationID     int64           `json:"station_id,omitempty"`
+	EventID       string          `json:"event_id,omitempty"`
+}
+
+// scope returns ctx carrying the correlation ID, station and event ID of the
+// envelope. Entries published before stations existed belong to the default one.
+func (e *Envelope) scope(ctx context.Context) context.Context {
+	ctx = appshared.WithEventID(appshared.WithCorrelationID(ctx, e.CorrelationID), e.EventID)
+	if e.StationID == 0 {
+		return appshared.WithStationID(ctx, station.DefaultID)
+	}
+	return appshared.WithStationID(ctx, e.StationID)
+}
+
+// StreamBus is a Redis Streams implementation of EventPublisher that lets
+// several backend replicas share events.
+//
+// Workers are handlers that must run once per event across all replicas,
+// such as recording plays. Each reads through its own consumer group, named
+// after the base group and the worker, acknowledges on success and reclaims
+// entries left pending by failed or crashed consumers, so a failing worker
+// is retried without running the others again. Broadcast handlers, such as
+// the SSE and WebSocket fan-out, must run on every replica and read the
+// stream directly.
+type StreamBus struct {
+	client    *redis.Client
+	stream    string
+	group     string
+	start     string
+	consumer  string
+	workers   map[string]*InMemoryPublisher
+	broadcast *InMemoryPublisher
+	logger    *logger.Logger
+
+	isStarted atomic.Bool
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewStreamBus creates a new StreamBus.
+// New consumer groups start reading after the start stream ID.
+func NewStreamBus(client *redis.Client, stream, group, start string, workers map[string]*InMemoryPublisher, broadcast *InMemoryPublisher, log *logger.Logger) *StreamBus {
+	host, _ := os.Hostname()
+	return &StreamBus{
+		client:    client,
+		stream:    stream,
+		group:     group,
+		start:     start,
+		consumer:  fmt.Sprintf("%s-%d", host, os.Getpid()),
+		workers:   workers,
+		broadcast: broadcast,
+		logger:    log,
+	}
+}
+
+// Ensure StreamBus implements EventPublisher.
+var _ appshared.EventPublisher = (*StreamBus)(nil)
+
+// Publish appends a domain event to the stream.
+func (b *StreamBus) Publish(ctx context.Context, event shared.DomainEvent) error {
+	payload, err := outbox.Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", event.EventName(), err)
+	}
+
+	envelope, err := json.Marshal(Envelope{
+		Name:          event.EventName(),
+		OccurredAt:    event.OccurredAt(),
+		Payload:       payload,
+		CorrelationID: appshared.CorrelationID(ctx),
+		StationID:     appshared.StationID(ctx),
+		EventID:       appshared.EventID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s envelope: %w", event.EventName(), err)
+	}
+
+	return b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.stream,
+		MaxLen: maxLen,
+		Approx: true,
+		Values: map[string]interface{}{envelopeField: envelope},
+	}).Err()
+}
+
+// PublishAll appends multiple domain events to the stream.
+func (b *StreamBus) PublishAll(ctx context.Context, events []shared.DomainEvent) error {
+	for _, event := range events {
+		if err := b.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dispatch appends the event to the stream, so the outbox relay hands
+// committed events over to the bus instead of running handlers itself.
+func (b *StreamBus) Dispatch(ctx context.Context, event shared.DomainEvent) error {
+	return b.Publish(ctx, event)
+}
+
+// Start starts consuming the stream in the background.
+func (b *StreamBus) Start() {
+	if !b.isStarted.CompareAndSwap(false, true) {
+		b.logger.Warn("Event bus already started")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+
+	// Create the groups before returning, so entries the relay appends
+	// right after startup are read by groups that start at "$"
+	for name := range b.workers {
+		b.ensureGroup(ctx, b.groupName(name), b.start)
+	}
+
+	for name := range b.workers {
+		b.wg.Add(2)
+		go b.consume(ctx, name)
+		go b.reclaim(ctx, name)
+	}
+	b.wg.Add(1)
+	go b.fanOut(ctx)
+
+	b.logger.Infof("Event bus started - stream %s, groups %s:*, consumer %s", b.stream, b.group, b.consumer)
+}
+
+// Stop stops consuming after the entries in progress.
+func (b *StreamBus) Stop(ctx context.Context) error {
+	if !b.isStarted.Load() {
+		return nil
+	}
+
+	b.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		b.isStarted.Store(false)
+		b.logger.Info("Event bus stopped gracefully")
+		return nil
+	case <-ctx.Done():
+		b.logger.Warn("Event bus stop timed out")
+		return ctx.Err()
+	}
+}
+
+// groupName returns the consumer group of a worker.
+func (b *StreamBus) groupName(worker string) string {
+	return b.group + ":" + worker
+}
+
+// consume reads new entries through the consumer group of a worker and runs it.
+func (b *StreamBus) consume(ctx context.Context, worker string) {
+	defer b.wg.Done()
+	group := b.groupName(worker)
+	log := b.logger.WithContext("eventbus", "consume").WithField("group", group)
+
+	for !b.ensureGroup(ctx, group, b.start) {
+		if !sleep(ctx, retryDelay) {
+			return
+		}
+	}
+
+	for ctx.Err() == nil {
+		streams, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: b.consumer,
+			Streams:  []string{b.stream, ">"},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			log.WithError(err).Error("failed to read from consumer group")
+			if strings.HasPrefix(err.Error(), "NOGROUP") {
+				// The stream was deleted under us: the new one holds
+				// only entries appended since, so read it whole
+				b.ensureGroup(ctx, group, "0")
+			}
+			sleep(ctx, retryDelay)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				b.process(ctx, worker, msg)
+			}
+		}
+	}
+}
+
+// reclaim takes over entries other consumers of a worker's group left
+// unacknowledged for too long.
+func (b *StreamBus) reclaim(ctx context.Context, worker string) {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(reclaimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := b.reclaimPending(ctx, worker); err != nil && ctx.Err() == nil {
+			b.logger.WithContext("eventbus", "reclaim").WithError(err).WithField("group", b.groupName(worker)).Error("failed to reclaim pending entries")
+		}
+	}
+}
+
+func (b *StreamBus) reclaimPending(ctx context.Context, worker string) error {
+	group := b.groupName(worker)
+	pending, err := b.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: b.stream,
+		Group:  group,
+		Idle:   claimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  readCount,
+	}).Result()
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	deliveries := make(map[string]int64, len(pending))
+	ids := make([]string, 0, len(pending))
+	for _, p := range pending {
+		deliveries[p.ID] = p.RetryCount
+		ids = append(ids, p.ID)
+	}
+
+	messages, err := b.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   b.stream,
+		Group:    group,
+		Consumer: b.consumer,
+		MinIdle:  claimIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range messages {
+		if deliveries[msg.ID] >= maxDeliveries {
+			b.deadLetter(ctx, group, msg, fmt.Errorf("gave up after %d deliveries", deliveries[msg.ID]))
+			continue
+		}
+		b.process(ctx, worker, msg)
+	}
+
+	return nil
+}
+
+// process runs a worker for an entry and acknowledges it on success.
+// Failed entries stay pending and are reclaimed later.
+func (b *StreamBus) process(ctx context.Context, worker string, msg redis.XMessage) {
+	group := b.groupName(worker)
+	log := b.logger.WithContext("eventbus", "process").WithField("entry_id", msg.ID).WithField("group", group)
+
+	event, envelope, err := decodeEntry(msg)
+	if err != nil {
+		// Retrying won't make an undecodable entry decodable
+		b.deadLetter(ctx, group, msg, err)
+		return
+	}
+	log = log.WithField("event", event.EventName())
+
+	dispatchCtx, cancel := context.WithTimeout(envelope.scope(ctx), claimIdle)
+	err = b.workers[worker].Dispatch(dispatchCtx, event)
+	cancel()
+
+	if err != nil {
+		log.WithError(err).Warn("event handling failed, leaving entry pending")
+		return
+	}
+
+	if err := b.client.XAck(ctx, b.stream, group, msg.ID).Err(); err != nil {
+		log.WithError(err).Error("failed to acknowledge entry")
+	}
+}
+
+// deadLetter moves an entry a group gave up on to the dead-letter stream
+// and acknowledges it there.
+func (b *StreamBus) deadLetter(ctx context.Context, group string, msg redis.XMessage, cause error) {
+	log := b.logger.WithContext("eventbus", "dead_letter").WithField("entry_id", msg.ID).WithField("group", group)
+	log.WithError(cause).Error("dead-lettering event")
+
+	values := map[string]interface{}{"entry_id": msg.ID, "group": group, "error": cause.Error()}
+	if envelope, ok := msg.Values[envelopeField]; ok {
+		values[envelopeField] = envelope
+	}
+
+	if err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.stream + ":dead",
+		MaxLen: maxLen,
+		Approx: true,
+		Values: values,
+	}).Err(); err != nil {
+		log.WithError(err).Error("failed to dead-letter event")
+		return
+	}
+
+	if err := b.client.XAck(ctx, b.stream, group, msg.ID).Err(); err != nil {
+		log.WithError(err).Error("failed to acknowledge entry")
+	}
+}
+
+// fanOut reads every new entry and runs the broadcast handlers on this replica.
+func (b *StreamBus) fanOut(ctx context.Context) {
+	defer b.wg.Done()
+	log := b.logger.WithContext("eventbus", "fan_out")
+
+	// Start after the newest entry rather than "$", so nothing published
+	// between two reads is missed
+	lastID := "0-0"
+	for ctx.Err() == nil {
+		latest, err := b.client.XRevRangeN(ctx, b.stream, "+", "-", 1).Result()
+		if err == nil {
+			if len(latest) > 0 {
+				lastID = latest[0].ID
+			}
+			break
+		}
+		log.WithError(err).Error("failed to read stream position")
+		sleep(ctx, retryDelay)
+	}
+
+	for ctx.Err() == nil {
+		streams, err := b.client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{b.stream, lastID},
+			Count:   readCount,
+			Block:   readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			log.WithError(err).Error("failed to read from stream")
+			sleep(ctx, retryDelay)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				lastID = msg.ID
+
+				event, envelope, err := decodeEntry(msg)
+				if err != nil {
+					log.WithError(err).WithField("entry_id", msg.ID).Warn("skipping undecodable entry")
+					continue
+				}
+
+				dispatchCtx, cancel := context.WithTimeout(envelope.scope(ctx), claimIdle)
+				if err := b.broadcast.Dispatch(dispatchCtx, event); err != nil {
+					log.WithError(err).WithField("entry_id", msg.ID).Warn("broadcast handler failed")
+				}
+				cancel()
+			}
+		}
+	}
+}
+
+// ensureGroup creates the consumer group, reading after the start stream
+// ID, and the stream if needed. An existing group keeps its position.
+// Starting a new group at "0" would replay up to maxLen old entries to its
+// worker, re-sending webhooks and re-recording plays.
+func (b *StreamBus) ensureGroup(ctx context.Context, group, start string) bool {
+	err := b.client.XGroupCreateMkStream(ctx, b.stream, group, start).Err()
+	if err == nil || strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return true
+	}
+	if ctx.Err() == nil {
+		b.logger.WithContext("eventbus", "ensure_group").WithError(err).WithField("group", group).Error("failed to create consumer group")
+	}
+	return false
+}
+
+// decodeEntry rebuilds the domain event carried by a stream entry.
+func decodeEntry(msg redis.XMessage) (shared.DomainEvent, *Envelope, error) {
+	raw, ok := msg.Values[envelopeField].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("entry %s has no envelope", msg.ID)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal([]byte(raw), &envelope); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode envelope: %w", err)
+	}
+
+	event, err := outbox.Decode(outbox.Message{
+		EventName:  envelope.Name,
+		Payload:    envelope.Payload,
+		OccurredAt: envelope.OccurredAt,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return event, &envelope, nil
+}
+
+// sleep waits for d and reports whether ctx is still alive.
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
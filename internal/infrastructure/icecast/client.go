@@ -1,58 +1,56 @@
 package icecast
 
 import (
-	"fmt"
-	"hub/internal/config"
-	"io"
-	"net/http"
+	"context"
+	"errors"
+	"hub/internal/infrastructure/breaker"
+	"hub/internal/infrastructure/streaming"
 )
 
-type (
-	Client interface {
-		MountStats() (*ResponseSourceStats, error)
-		ListClients() (*ResponseClientList, error)
-	}
+// client reads the admin interface of an Icecast server.
+type client struct {
+	host     string
+	user     string
+	password string
+	mounts   []string
+	http     *streaming.HTTPClient
+}
 
-	client struct {
-		host     string
-		user     string
-		password string
-		mount    string
+func NewClient(host, user, password string, mounts []string, http *streaming.HTTPClient) (streaming.Client, error) {
+	if len(mounts) == 0 {
+		return nil, errors.New("no Icecast mount configured")
 	}
-)
-
-func NewClient(cfg config.Config) (Client, error) {
-	host, user, password, mount := cfg.IcecastConnection()
 
 	return &client{
 		host:     host,
 		user:     user,
 		password: password,
-		mount:    mount,
+		mounts:   mounts,
+		http:     http,
 	}, nil
 }
 
-func (c *client) request(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.host, url), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.SetBasicAuth(c.user, c.password)
+func (c *client) Mounts() []string {
+	return c.mounts
+}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("Can't send request to Icecast API: %w", err)
+func (c *client) hasMount(mount string) bool {
+	for _, m := range c.mounts {
+		if m == mount {
+			return true
+		}
 	}
-	defer resp.Body.Close()
+	return false
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Icecast API returned status %d", resp.StatusCode)
-	}
+func (c *client) ListsListeners() bool {
+	return true
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+func (c *client) Breaker() *breaker.Breaker {
+	return c.http.Breaker()
+}
 
-	return body, nil
+func (c *client) request(ctx context.Context, url string) ([]byte, error) {
+	return c.http.Get(ctx, c.host+url, c.user, c.password)
 }
@@ -1,8 +1,11 @@
 package icecast
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
+	"hub/internal/infrastructure/streaming"
+	"net/url"
 	"strings"
 )
 
@@ -23,22 +26,14 @@ type (
 		Lag       int    `xml:"lag"`
 		Connected int    `xml:"Connected"`
 	}
+)
 
-	ResponseClientList struct {
-		Count     int
-		Listeners []ResponseListener
-	}
-
-	ResponseListener struct {
-		ID        int
-		IP        string
-		UserAgent string
-		Connected int
+func (c *client) ListClients(ctx context.Context, mount string) (*streaming.ClientList, error) {
+	if !c.hasMount(mount) {
+		return nil, fmt.Errorf("mount %s: %w", mount, streaming.ErrMountNotFound)
 	}
-)
 
-func (c *client) ListClients() (*ResponseClientList, error) {
-	byte, err := c.request(fmt.Sprintf("/admin/listclients?mount=%s", c.mount))
+	byte, err := c.request(ctx, "/admin/listclients?mount="+url.QueryEscape(mount))
 	if err != nil {
 		return nil, err
 	}
@@ -49,15 +44,15 @@ func (c *client) ListClients() (*ResponseClientList, error) {
 	}
 
 	for _, source := range stats.Sources {
-		if source.Mount == c.mount {
+		if source.Mount == mount {
 
-			resp := &ResponseClientList{
+			resp := &streaming.ClientList{
 				Count:     source.Listeners,
-				Listeners: make([]ResponseListener, 0, len(source.Listener)),
+				Listeners: make([]streaming.Listener, 0, len(source.Listener)),
 			}
 
 			for _, l := range source.Listener {
-				resp.Listeners = append(resp.Listeners, ResponseListener{
+				resp.Listeners = append(resp.Listeners, streaming.Listener{
 					ID:        l.ID,
 					IP:        strings.TrimSpace(l.IP),
 					UserAgent: strings.TrimSpace(l.UserAgent),
@@ -69,5 +64,5 @@ func (c *client) ListClients() (*ResponseClientList, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("mount %s not found", c.mount)
+	return nil, fmt.Errorf("mount %s: %w", mount, streaming.ErrMountNotFound)
 }
@@ -1,9 +1,10 @@
 package icecast
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"regexp"
+	"hub/internal/infrastructure/streaming"
 )
 
 type (
@@ -26,24 +27,10 @@ type (
 		MetadataURL  string `xml:"metadata_url"`
 		AudioInfo    string `xml:"audio_info"`
 	}
-
-	ResponseSourceStats struct {
-		Name         string
-		Description  string
-		StreamURL    string
-		Listeners    int
-		ListenerPeak int
-		Genre        string
-		Bitrate      string
-		Title        string
-		StreamStart  string
-		MetadataURL  string
-		AudioInfo    string
-	}
 )
 
-func (c *client) MountStats() (*ResponseSourceStats, error) {
-	body, err := c.request("/admin/stats")
+func (c *client) MountStats(ctx context.Context) ([]*streaming.SourceStats, error) {
+	body, err := c.request(ctx, "/admin/stats")
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +40,32 @@ func (c *client) MountStats() (*ResponseSourceStats, error) {
 		return nil, fmt.Errorf("failed to parse XML: %w", err)
 	}
 
+	bySource := make(map[string]icecastMountSourceStat, len(stats.Sources))
 	for _, source := range stats.Sources {
-		if source.Mount == c.mount {
-			return &ResponseSourceStats{
-				Name:         source.ServerName,
-				Description:  source.ServerDesc,
-				StreamURL:    source.ServerURL,
-				Listeners:    source.Listeners,
-				ListenerPeak: source.ListenerPeak,
-				Genre:        source.Genre,
-				Bitrate:      source.Bitrate,
-				Title:        source.Title,
-				StreamStart:  source.StreamStart,
-				MetadataURL:  source.MetadataURL,
-				AudioInfo:    source.AudioInfo,
-			}, nil
-		}
+		bySource[source.Mount] = source
 	}
 
-	return nil, fmt.Errorf("mount %s not found", c.mount)
-}
-
-// ExtractTrackID extracts MD5 track ID from title format: "Artist - Title [MD5]"
-func ExtractTrackID(title string) string {
-	re := regexp.MustCompile(`\[([a-f0-9]{32})\]$`)
-	matches := re.FindStringSubmatch(title)
-	if len(matches) > 1 {
-		return matches[1]
+	live := make([]*streaming.SourceStats, 0, len(c.mounts))
+	for _, mount := range c.mounts {
+		source, ok := bySource[mount]
+		if !ok {
+			continue
+		}
+		live = append(live, &streaming.SourceStats{
+			Mount:        mount,
+			Name:         source.ServerName,
+			Description:  source.ServerDesc,
+			StreamURL:    source.ServerURL,
+			Listeners:    source.Listeners,
+			ListenerPeak: source.ListenerPeak,
+			Genre:        source.Genre,
+			Bitrate:      source.Bitrate,
+			Title:        source.Title,
+			StreamStart:  source.StreamStart,
+			MetadataURL:  source.MetadataURL,
+			AudioInfo:    source.AudioInfo,
+		})
 	}
-	return ""
+
+	return live, nil
 }
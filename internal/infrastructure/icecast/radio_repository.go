@@ -4,15 +4,16 @@ import (
 	"context"
 
 	"hub/internal/domain/radio"
+	"hub/internal/infrastructure/streaming"
 )
 
 // RadioRepository implements radio.Repository using Icecast.
 type RadioRepository struct {
-	client Client
+	client streaming.Client
 }
 
 // NewRadioRepository creates a new RadioRepository.
-func NewRadioRepository(client Client) *RadioRepository {
+func NewRadioRepository(client streaming.Client) *RadioRepository {
 	return &RadioRepository{client: client}
 }
 
@@ -21,10 +22,14 @@ var _ radio.Repository = (*RadioRepository)(nil)
 
 // GetCurrentInfo returns the current radio stream information.
 func (r *RadioRepository) GetCurrentInfo(ctx context.Context) (*radio.RadioInfo, error) {
-	stats, err := r.client.MountStats()
+	live, err := r.client.MountStats(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if len(live) == 0 {
+		return nil, radio.ErrNoActiveStream
+	}
+	stats := live[0]
 
 	info := radio.NewRadioInfo(
 		stats.Name,
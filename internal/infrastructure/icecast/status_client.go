@@ -0,0 +1,135 @@
+package icecast
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hub/internal/infrastructure/streaming"
+	"net/url"
+)
+
+type (
+	// statusClient reads the public /status-json.xsl endpoint, for servers
+	// whose admin interface is not reachable. It reports mount stats only:
+	// the status has no per-listener data, so ListClients always fails with
+	// ErrListenersUnavailable.
+	statusClient struct {
+		*client
+	}
+
+	icecastStatus struct {
+		Icestats struct {
+			Source statusSources `json:"source"`
+		} `json:"icestats"`
+	}
+
+	// statusSources decodes "source", which Icecast renders as an object for
+	// a single live mount, an array for several and leaves out for none.
+	statusSources []statusSource
+
+	statusSource struct {
+		ListenURL         string               `json:"listenurl"`
+		ServerName        streaming.FlexString `json:"server_name"`
+		ServerDescription streaming.FlexString `json:"server_description"`
+		ServerURL         string               `json:"server_url"`
+		Listeners         int                  `json:"listeners"`
+		ListenerPeak      int                  `json:"listener_peak"`
+		Genre             streaming.FlexString `json:"genre"`
+		Bitrate           streaming.FlexString `json:"bitrate"`
+		Title             streaming.FlexString `json:"title"`
+		StreamStart       string               `json:"stream_start"`
+		AudioInfo         string               `json:"audio_info"`
+	}
+)
+
+// NewStatusClient creates a client reading the public status of the server.
+func NewStatusClient(host string, mounts []string, http *streaming.HTTPClient) (streaming.Client, error) {
+	if len(mounts) == 0 {
+		return nil, errors.New("no Icecast mount configured")
+	}
+
+	return &statusClient{client: &client{host: host, mounts: mounts, http: http}}, nil
+}
+
+func (c *statusClient) MountStats(ctx context.Context) ([]*streaming.SourceStats, error) {
+	body, err := c.request(ctx, "/status-json.xsl")
+	if err != nil {
+		return nil, err
+	}
+
+	var status icecastStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("failed to parse status JSON: %w", err)
+	}
+
+	bySource := make(map[string]statusSource, len(status.Icestats.Source))
+	for _, source := range status.Icestats.Source {
+		bySource[source.mount()] = source
+	}
+
+	live := make([]*streaming.SourceStats, 0, len(c.mounts))
+	for _, mount := range c.mounts {
+		source, ok := bySource[mount]
+		if !ok {
+			continue
+		}
+		live = append(live, &streaming.SourceStats{
+			Mount:        mount,
+			Name:         string(source.ServerName),
+			Description:  string(source.ServerDescription),
+			StreamURL:    source.ServerURL,
+			Listeners:    source.Listeners,
+			ListenerPeak: source.ListenerPeak,
+			Genre:        string(source.Genre),
+			Bitrate:      string(source.Bitrate),
+			Title:        string(source.Title),
+			StreamStart:  source.StreamStart,
+			AudioInfo:    source.AudioInfo,
+		})
+	}
+
+	return live, nil
+}
+
+func (c *statusClient) ListsListeners() bool {
+	return false
+}
+
+func (c *statusClient) ListClients(ctx context.Context, mount string) (*streaming.ClientList, error) {
+	return nil, fmt.Errorf("mount %s: %w", mount, streaming.ErrListenersUnavailable)
+}
+
+// mount returns the mount of a source. The status has no mount field, so
+// it is the path of the listen URL.
+func (s statusSource) mount() string {
+	u, err := url.Parse(s.ListenURL)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+func (s *statusSources) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*s = nil
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var source statusSource
+		if err := json.Unmarshal(data, &source); err != nil {
+			return err
+		}
+		*s = statusSources{source}
+		return nil
+	default:
+		var sources []statusSource
+		if err := json.Unmarshal(data, &sources); err != nil {
+			return err
+		}
+		*s = sources
+		return nil
+	}
+}
@@ -0,0 +1,190 @@
+package leader
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"hub/internal/infrastructure/metrics"
+	"hub/internal/logger"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Roles reported by Elector.Role.
+const (
+	RoleDisabled = "disabled"
+	RoleLeader   = "leader"
+	RoleFollower = "follower"
+)
+
+// Elector elects a single leader among the replicas using a PostgreSQL
+// session-level advisory lock.
+//
+// The leader keeps one pooled connection holding the lock and pings it every
+// interval. If the leader dies its session ends and PostgreSQL releases the
+// lock, so a follower takes over on its next attempt, within one interval.
+type Elector struct {
+	pool     *pgxpool.Pool
+	key      int64
+	interval time.Duration
+	metrics  *metrics.Metrics
+	logger   *logger.Logger
+
+	conn     *pgxpool.Conn
+	isLeader atomic.Bool
+
+	isStarted atomic.Bool
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewElector creates a new Elector competing for the named lock.
+func NewElector(pool *pgxpool.Pool, name string, interval time.Duration, m *metrics.Metrics, log *logger.Logger) *Elector {
+	return &Elector{
+		pool:     pool,
+		key:      lockKey(name),
+		interval: interval,
+		metrics:  m,
+		logger:   log,
+	}
+}
+
+// IsLeader reports whether this replica currently holds the leadership.
+func (e *Elector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// Role reports whether this replica is the leader, a follower, or not
+// taking part in the election.
+func (e *Elector) Role() string {
+	switch {
+	case !e.isStarted.Load():
+		return RoleDisabled
+	case e.IsLeader():
+		return RoleLeader
+	default:
+		return RoleFollower
+	}
+}
+
+// Start starts campaigning for the leadership in the background.
+func (e *Elector) Start() {
+	if !e.isStarted.CompareAndSwap(false, true) {
+		e.logger.Warn("Leader elector already started")
+		return
+	}
+
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+
+	go e.run()
+	e.logger.Infof("Leader elector started - campaigning every %s", e.interval)
+}
+
+// Stop gives up the leadership, if held, and stops campaigning.
+func (e *Elector) Stop(ctx context.Context) error {
+	if !e.isStarted.Load() {
+		return nil
+	}
+
+	close(e.stop)
+
+	select {
+	case <-e.done:
+		e.isStarted.Store(false)
+		e.logger.Info("Leader elector stopped gracefully")
+		return nil
+	case <-ctx.Done():
+		e.logger.Warn("Leader elector stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (e *Elector) run() {
+	defer close(e.done)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.campaign()
+
+		select {
+		case <-e.stop:
+			e.resign()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign keeps the lock alive when leading, or tries to take it otherwise.
+func (e *Elector) campaign() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+	defer cancel()
+
+	log := e.logger.WithContext("leader", "campaign")
+
+	if e.conn != nil {
+		if err := e.conn.Ping(ctx); err != nil {
+			// The session may be gone, and the lock with it
+			log.WithError(err).Warn("lost connection holding the leader lock, stepping down")
+			e.release(false)
+		}
+		return
+	}
+
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		log.WithError(err).Error("failed to acquire connection for leader election")
+		return
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired); err != nil {
+		log.WithError(err).Error("failed to try leader lock")
+		conn.Release()
+		return
+	}
+	if !acquired {
+		conn.Release()
+		return
+	}
+
+	e.conn = conn
+	e.isLeader.Store(true)
+	e.metrics.SetLeader(true)
+	log.Info("Elected scheduler leader")
+}
+
+// resign releases the lock so a follower can take over right away.
+func (e *Elector) resign() {
+	if e.conn != nil {
+		e.release(true)
+	}
+}
+
+// release drops the connection holding the lock. The connection is closed
+// rather than returned to the pool unless the lock was released cleanly,
+// so no pooled session keeps holding it.
+func (e *Elector) release(unlock bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+	defer cancel()
+
+	unlocked := false
+	if unlock {
+		if _, err := e.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", e.key); err == nil {
+			unlocked = true
+		}
+	}
+	if !unlocked {
+		_ = e.conn.Conn().Close(ctx)
+	}
+	e.conn.Release()
+	e.conn = nil
+
+	e.isLeader.Store(false)
+	e.metrics.SetLeader(false)
+	e.logger.WithContext("leader", "release").Info("Gave up scheduler leadership")
+}
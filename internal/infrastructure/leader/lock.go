@@ -0,0 +1,93 @@
+package leader
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// unlockTimeout bounds releasing a lock, which must not hang on a dead connection.
+const unlockTimeout = 5 * time.Second
+
+// Locks hands out cluster-wide named locks backed by PostgreSQL
+// session-level advisory locks. A lock is held by a pooled connection until
+// released; if the replica dies, PostgreSQL releases it with the session.
+type Locks struct {
+	pool *pgxpool.Pool
+}
+
+// NewLocks creates a new Locks.
+func NewLocks(pool *pgxpool.Pool) *Locks {
+	return &Locks{pool: pool}
+}
+
+// TryAcquire takes the named lock if no one holds it. When acquired, the
+// returned func releases it.
+func (l *Locks) TryAcquire(ctx context.Context, name string) (func(), bool, error) {
+	return l.acquire(ctx, name, "SELECT pg_try_advisory_lock($1)")
+}
+
+// Acquire waits for the named lock until ctx is done, and returns the
+// func releasing it.
+func (l *Locks) Acquire(ctx context.Context, name string) (func(), error) {
+	release, _, err := l.acquire(ctx, name, "SELECT true FROM pg_advisory_lock($1)")
+	return release, err
+}
+
+// Held reports whether anyone holds the named lock.
+func (l *Locks) Held(ctx context.Context, name string) (bool, error) {
+	// A bigint advisory key is stored as its high and low halves
+	key := uint64(lockKey(name))
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM pg_locks
+			WHERE locktype = 'advisory' AND granted
+				AND classid::bigint = $1 AND objid::bigint = $2 AND objsubid = 1
+		)
+	`
+
+	var held bool
+	err := l.pool.QueryRow(ctx, query, int64(key>>32), int64(key&0xFFFFFFFF)).Scan(&held)
+	return held, err
+}
+
+func (l *Locks) acquire(ctx context.Context, name, query string) (func(), bool, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := lockKey(name)
+	var acquired bool
+	if err := conn.QueryRow(ctx, query, key).Scan(&acquired); err != nil {
+		// A cancelled wait may leave the session in doubt; don't reuse it
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+		return nil, false, err
+	}
+	if !acquired {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	release := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), unlockTimeout)
+		defer cancel()
+
+		// Closing the session frees the lock if unlocking fails
+		if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", key); err != nil {
+			_ = conn.Conn().Close(ctx)
+		}
+		conn.Release()
+	}
+	return release, true, nil
+}
+
+// lockKey maps a lock name to an advisory lock key.
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
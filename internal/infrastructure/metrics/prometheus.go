@@ -1,10 +1,12 @@
 package metrics
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // Metrics holds all Prometheus metrics.
@@ -14,7 +16,12 @@ type Metrics struct {
 	dbQueryDuration     *prometheus.HistogramVec
 	cacheHits           *prometheus.CounterVec
 	cacheMisses         *prometheus.CounterVec
-	activeListeners     prometheus.Gauge
+	activeListeners     *prometheus.GaugeVec
+	icecastBreaker      *prometheus.GaugeVec
+	schedulerLeader     prometheus.Gauge
+	leaderTransitions   prometheus.Counter
+	jobRunsTotal        *prometheus.CounterVec
+	jobRunDuration      *prometheus.HistogramVec
 }
 
 // NewMetrics creates a new Metrics instance.
@@ -57,11 +64,46 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"key"},
 		),
-		activeListeners: promauto.NewGauge(
+		activeListeners: promauto.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: "active_listeners",
 				Help: "Current number of active listeners",
 			},
+			[]string{"station"},
+		),
+		icecastBreaker: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "icecast_circuit_breaker_state",
+				Help: "State of the circuit breaker of a station's streaming server: 0 closed, 1 half-open, 2 open",
+			},
+			[]string{"station"},
+		),
+		schedulerLeader: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "scheduler_leader",
+				Help: "Whether this replica holds the scheduler leadership (1) or not (0)",
+			},
+		),
+		leaderTransitions: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "scheduler_leader_transitions_total",
+				Help: "Total number of times this replica gained or lost the scheduler leadership",
+			},
+		),
+		jobRunsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "job_runs_total",
+				Help: "Total number of scheduled job runs",
+			},
+			[]string{"job", "status"},
+		),
+		jobRunDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "job_run_duration_seconds",
+				Help:    "Scheduled job run duration in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"job"},
 		),
 	}
 }
@@ -87,7 +129,37 @@ func (m *Metrics) RecordCacheMiss(key string) {
 	m.cacheMisses.WithLabelValues(key).Inc()
 }
 
-// SetActiveListeners sets the active listeners gauge.
-func (m *Metrics) SetActiveListeners(count int) {
-	m.activeListeners.Set(float64(count))
+// SetActiveListeners sets the active listeners gauge of a station.
+func (m *Metrics) SetActiveListeners(station string, count int) {
+	m.activeListeners.WithLabelValues(station).Set(float64(count))
+}
+
+// SetCircuitBreakerState sets the circuit breaker state gauge of a station.
+func (m *Metrics) SetCircuitBreakerState(station string, state int) {
+	m.icecastBreaker.WithLabelValues(station).Set(float64(state))
+}
+
+// SetLeader records whether this replica holds the scheduler leadership.
+func (m *Metrics) SetLeader(leader bool) {
+	value := 0.0
+	if leader {
+		value = 1
+	}
+	m.schedulerLeader.Set(value)
+	m.leaderTransitions.Inc()
+}
+
+// RecordJobRun records a finished job run.
+func (m *Metrics) RecordJobRun(job string, succeeded bool, duration time.Duration) {
+	status := "succeeded"
+	if !succeeded {
+		status = "failed"
+	}
+	m.jobRunsTotal.WithLabelValues(job, status).Inc()
+	m.jobRunDuration.WithLabelValues(job).Observe(duration.Seconds())
+}
+
+// Handler returns the HTTP handler exposing the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.Handler()
 }
@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"hub/internal/domain/listener"
+	"hub/internal/domain/reaction"
+	"hub/internal/domain/shared"
+	"hub/internal/domain/track"
+)
+
+// ErrUnknownEvent is returned when a stored event has no decoder.
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Encode serializes a domain event's payload for storage.
+func Encode(event shared.DomainEvent) ([]byte, error) {
+	return json.Marshal(event.Payload())
+}
+
+// payload mirrors the keys domain events use in Payload.
+type payload struct {
+	TrackID      string `json:"track_id"`
+	UserID       string `json:"user_id"`
+	Title        string `json:"title"`
+	Cover        string `json:"cover"`
+	OldCover     string `json:"old_cover"`
+	NewCover     string `json:"new_cover"`
+	NewRotate    int    `json:"new_rotate"`
+	From         string `json:"from"`
+	ReactionType string `json:"reaction_type"`
+	Listeners    int    `json:"listeners"`
+}
+
+// Decode rebuilds the domain event stored in a message.
+func Decode(msg Message) (shared.DomainEvent, error) {
+	var p payload
+	if err := json.Unmarshal(msg.Payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode %s payload: %w", msg.EventName, err)
+	}
+
+	switch msg.EventName {
+	case track.EventTrackCreated:
+		id, err := track.NewTrackID(p.TrackID)
+		if err != nil {
+			return nil, err
+		}
+		title, err := track.NewTitle(p.Title)
+		if err != nil {
+			return nil, err
+		}
+		return track.ReconstructTrackCreated(id, title, track.NewCover(p.Cover), msg.OccurredAt), nil
+
+	case track.EventTrackRotated:
+		id, err := track.NewTrackID(p.TrackID)
+		if err != nil {
+			return nil, err
+		}
+		return track.ReconstructTrackRotated(id, p.NewRotate, msg.OccurredAt), nil
+
+	case track.EventCoverUpdated:
+		id, err := track.NewTrackID(p.TrackID)
+		if err != nil {
+			return nil, err
+		}
+		return track.ReconstructCoverUpdated(id, track.NewCover(p.OldCover), track.NewCover(p.NewCover), msg.OccurredAt), nil
+
+	case reaction.EventReactionAdded, reaction.EventReactionChanged, reaction.EventReactionRemoved:
+		return decodeReaction(msg.EventName, p, msg.OccurredAt)
+
+	case listener.EventListenersCounted:
+		return listener.ReconstructListenersCounted(p.Listeners, msg.OccurredAt), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, msg.EventName)
+	}
+}
+
+func decodeReaction(name string, p payload, occurredAt time.Time) (shared.DomainEvent, error) {
+	userID, err := reaction.NewUserID(p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	trackID, err := track.NewTrackID(p.TrackID)
+	if err != nil {
+		return nil, err
+	}
+	reactionType, err := reaction.NewReactionType(p.ReactionType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case reaction.EventReactionAdded:
+		return reaction.ReconstructReactionAdded(userID, trackID, reactionType, occurredAt), nil
+	case reaction.EventReactionRemoved:
+		return reaction.ReconstructReactionRemoved(userID, trackID, reactionType, occurredAt), nil
+	default:
+		from, err := reaction.NewReactionType(p.From)
+		if err != nil {
+			return nil, err
+		}
+		return reaction.ReconstructReactionChanged(userID, trackID, from, reactionType, occurredAt), nil
+	}
+}
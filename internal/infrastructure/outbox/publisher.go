@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/shared"
+)
+
+// Publisher implements EventPublisher by writing events to the outbox.
+// Publishing inside a unit of work stores the events in the same transaction
+// as the aggregate change; the Relay delivers them once committed.
+type Publisher struct {
+	store Store
+}
+
+// NewPublisher creates a new Publisher.
+func NewPublisher(store Store) *Publisher {
+	return &Publisher{store: store}
+}
+
+// Ensure Publisher implements EventPublisher.
+var _ appshared.EventPublisher = (*Publisher)(nil)
+
+// Publish stores a domain event in the outbox.
+func (p *Publisher) Publish(ctx context.Context, event shared.DomainEvent) error {
+	payload, err := Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", event.EventName(), err)
+	}
+	return p.store.Append(ctx, Message{
+		EventName:     event.EventName(),
+		CorrelationID: appshared.CorrelationID(ctx),
+		StationID:     appshared.StationID(ctx),
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt(),
+	})
+}
+
+// PublishAll stores multiple domain events in the outbox.
+func (p *Publisher) PublishAll(ctx context.Context, events []shared.DomainEvent) error {
+	for _, event := range events {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
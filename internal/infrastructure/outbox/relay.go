@@ -0,0 +1,254 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/shared"
+	"hub/internal/infrastructure/retry"
+	"hub/internal/logger"
+)
+
+const (
+	// dispatchTimeout is how long a subscriber may take to handle a message.
+	dispatchTimeout = 30 * time.Second
+
+	// lease is how long a claimed message is hidden from other relays. It is
+	// renewed before each dispatch and outlasts one, so a message is never
+	// delivered twice concurrently however long its batch takes.
+	lease = 2 * dispatchTimeout
+
+	baseBackoff = time.Second
+	maxBackoff  = 5 * time.Minute
+)
+
+// Dispatcher delivers a domain event to its handlers and reports their failure.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, event shared.DomainEvent) error
+}
+
+// Routing tells the relay where to deliver messages.
+type Routing struct {
+	// Subscribers get every message at least once, by name. Delivery is
+	// tracked per subscriber, so a failing one is retried without running
+	// the others again.
+	Subscribers map[string]Dispatcher
+
+	// Broadcast, if set, gets every message once, when it is first
+	// claimed. Its failures are logged, not retried.
+	Broadcast Dispatcher
+}
+
+// Relay delivers outbox messages to the subscribers at least once.
+// Failed deliveries are retried with exponential backoff until maxAttempts,
+// then dead-lettered. Subscribers must therefore tolerate duplicates.
+type Relay struct {
+	store       Store
+	routing     Routing
+	subscribers []string
+	logger      *logger.Logger
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+
+	isStarted atomic.Bool
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewRelay creates a new Relay.
+func NewRelay(store Store, routing Routing, interval time.Duration, batchSize, maxAttempts int, log *logger.Logger) *Relay {
+	subscribers := make([]string, 0, len(routing.Subscribers))
+	for name := range routing.Subscribers {
+		subscribers = append(subscribers, name)
+	}
+	sort.Strings(subscribers)
+
+	return &Relay{
+		store:       store,
+		routing:     routing,
+		subscribers: subscribers,
+		logger:      log,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Start starts polling the outbox in the background.
+func (r *Relay) Start() {
+	if !r.isStarted.CompareAndSwap(false, true) {
+		r.logger.Warn("Outbox relay already started")
+		return
+	}
+
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+
+	go r.run()
+	r.logger.Infof("Outbox relay started - polling every %s", r.interval)
+}
+
+// Stop stops the relay after the batch in progress.
+func (r *Relay) Stop(ctx context.Context) error {
+	if !r.isStarted.Load() {
+		return nil
+	}
+
+	close(r.stop)
+
+	select {
+	case <-r.done:
+		r.isStarted.Store(false)
+		r.logger.Info("Outbox relay stopped gracefully")
+		return nil
+	case <-ctx.Done():
+		r.logger.Warn("Outbox relay stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (r *Relay) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		// Drain the backlog before waiting for the next tick
+		for {
+			n, err := r.relayBatch(context.Background())
+			if err != nil {
+				r.logger.WithContext("outbox", "relay").WithError(err).Error("failed to relay outbox batch")
+			}
+			if err != nil || n < r.batchSize {
+				break
+			}
+
+			select {
+			case <-r.stop:
+				return
+			default:
+			}
+		}
+
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch delivers one batch of due messages and returns how many were claimed.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	messages, err := r.store.Claim(ctx, r.batchSize, lease)
+	if err != nil {
+		return 0, err
+	}
+
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+
+	for _, msg := range messages {
+		r.deliver(ctx, msg)
+	}
+
+	return len(messages), nil
+}
+
+func (r *Relay) deliver(ctx context.Context, msg Message) {
+	log := r.logger.WithContext("outbox", "deliver").
+		WithField("event_id", msg.ID).
+		WithField("event", msg.EventName)
+
+	event, err := Decode(msg)
+	if err != nil {
+		// Retrying won't make an undecodable message decodable
+		log.WithError(err).Error("dead-lettering undecodable event")
+		if err := r.store.MarkFailed(ctx, msg.ID, msg.Delivered, err, time.Now(), true); err != nil {
+			log.WithError(err).Error("failed to dead-letter event")
+		}
+		return
+	}
+
+	scoped := appshared.WithEventID(appshared.WithCorrelationID(ctx, msg.CorrelationID), strconv.FormatInt(msg.ID, 10))
+	scoped = appshared.WithStationID(scoped, msg.StationID)
+
+	if r.routing.Broadcast != nil && msg.Attempts == 0 {
+		if !r.renew(ctx, &msg) {
+			return
+		}
+		dispatchCtx, cancel := context.WithTimeout(scoped, dispatchTimeout)
+		if err := r.routing.Broadcast.Dispatch(dispatchCtx, event); err != nil {
+			log.WithError(err).Warn("broadcast handler failed")
+		}
+		cancel()
+	}
+
+	delivered := slices.Clone(msg.Delivered)
+	var errs []error
+	for _, name := range r.subscribers {
+		if slices.Contains(delivered, name) {
+			continue
+		}
+
+		if !r.renew(ctx, &msg) {
+			return
+		}
+
+		dispatchCtx, cancel := context.WithTimeout(scoped, dispatchTimeout)
+		err = r.routing.Subscribers[name].Dispatch(dispatchCtx, event)
+		cancel()
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		delivered = append(delivered, name)
+	}
+
+	if len(errs) == 0 {
+		if err := r.store.MarkDelivered(ctx, msg.ID); err != nil {
+			log.WithError(err).Error("failed to mark event delivered")
+		}
+		return
+	}
+	err = errors.Join(errs...)
+
+	attempts := msg.Attempts + 1
+	dead := attempts >= r.maxAttempts
+	if dead {
+		log.WithError(err).Errorf("dead-lettering event after %d attempts", attempts)
+	} else {
+		log.WithError(err).Warnf("event delivery failed, attempt %d of %d", attempts, r.maxAttempts)
+	}
+
+	if err := r.store.MarkFailed(ctx, msg.ID, delivered, err, time.Now().Add(retry.Backoff(attempts, baseBackoff, maxBackoff)), dead); err != nil {
+		log.WithError(err).Error("failed to record delivery failure")
+	}
+}
+
+// renew extends the lease of a message before a dispatch and reports
+// whether this relay still holds it.
+func (r *Relay) renew(ctx context.Context, msg *Message) bool {
+	log := r.logger.WithContext("outbox", "renew").WithField("event_id", msg.ID)
+
+	ok, err := r.store.Renew(ctx, msg, lease)
+	if err != nil {
+		// The lease runs out and the message is claimed again
+		log.WithError(err).Error("failed to renew the lease of event")
+		return false
+	}
+	if !ok {
+		// Another relay owns the message now and delivers the rest
+		log.Warn("lost the lease of event, leaving it to another relay")
+	}
+	return ok
+}
@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a domain event stored in the outbox.
+type Message struct {
+	ID            int64
+	EventName     string
+	CorrelationID string
+	StationID     int64
+	Payload       []byte
+	OccurredAt    time.Time
+	Attempts      int
+
+	// Delivered lists the subscribers that already handled the message.
+	Delivered []string
+
+	// LeasedUntil is when the lease of a claimed message expires. It
+	// identifies the lease, so a relay that lost it can't renew it.
+	LeasedUntil time.Time
+}
+
+// Store persists outbox messages.
+type Store interface {
+	// Append stores a message, inside the transaction carried by ctx if any.
+	Append(ctx context.Context, msg Message) error
+
+	// Claim leases up to limit pending messages that are due, so concurrent
+	// relays don't pick them up again until the lease expires.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Message, error)
+
+	// Renew extends the lease of a claimed message and updates its
+	// LeasedUntil. Returns false if the lease was lost to another relay.
+	Renew(ctx context.Context, msg *Message, lease time.Duration) (bool, error)
+
+	// MarkDelivered records that every subscriber handled the message.
+	MarkDelivered(ctx context.Context, id int64) error
+
+	// MarkFailed records a failed attempt and the subscribers that handled
+	// the message so far. The message is retried for the others at
+	// nextAttempt, or dead-lettered when dead is set.
+	MarkFailed(ctx context.Context, id int64, delivered []string, cause error, nextAttempt time.Time, dead bool) error
+
+	// Prune deletes messages delivered before the given time.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hub/internal/domain/apikey"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// APIKeyRepository implements apikey.Repository using PostgreSQL.
+type APIKeyRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository.
+func NewAPIKeyRepository(pool *pgxpool.Pool, m *metrics.Metrics) *APIKeyRepository {
+	return &APIKeyRepository{pool: pool, metrics: m}
+}
+
+var _ apikey.Repository = (*APIKeyRepository)(nil)
+
+// Save persists an API key. Only the revocation of an existing key is updated.
+func (r *APIKeyRepository) Save(ctx context.Context, k *apikey.APIKey) error {
+	defer observe(r.metrics, "api_keys.save")()
+
+	query := `
+		INSERT INTO api_keys (id, name, prefix, key_hash, scopes, created_at, last_used_at, revoked_at, station_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9::bigint, 0))
+		ON CONFLICT (id) DO UPDATE SET
+			revoked_at = EXCLUDED.revoked_at
+	`
+
+	scopes := make([]string, len(k.Scopes()))
+	for i, s := range k.Scopes() {
+		scopes[i] = s.String()
+	}
+
+	_, err := r.pool.Exec(ctx, query,
+		k.ID(),
+		k.Name(),
+		k.Prefix(),
+		k.Hash(),
+		scopes,
+		k.CreatedAt(),
+		k.LastUsedAt(),
+		k.RevokedAt(),
+		k.StationID(),
+	)
+	return err
+}
+
+// FindByID retrieves an API key by its ID. An ID that isn't a UUID matches no key.
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*apikey.APIKey, error) {
+	defer observe(r.metrics, "api_keys.find_by_id")()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apikey.ErrAPIKeyNotFound
+	}
+
+	query := `
+		SELECT id, name, prefix, key_hash, scopes, COALESCE(station_id, 0), created_at, last_used_at, revoked_at
+		FROM api_keys WHERE id = $1::uuid
+	`
+
+	return r.scanAPIKey(r.pool.QueryRow(ctx, query, id))
+}
+
+// FindByHash retrieves an API key by the hash of its secret.
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (*apikey.APIKey, error) {
+	defer observe(r.metrics, "api_keys.find_by_hash")()
+
+	query := `
+		SELECT id, name, prefix, key_hash, scopes, COALESCE(station_id, 0), created_at, last_used_at, revoked_at
+		FROM api_keys WHERE key_hash = $1
+	`
+
+	return r.scanAPIKey(r.pool.QueryRow(ctx, query, hash))
+}
+
+// FindAll retrieves every API key, newest first.
+func (r *APIKeyRepository) FindAll(ctx context.Context) ([]*apikey.APIKey, error) {
+	defer observe(r.metrics, "api_keys.find_all")()
+
+	query := `
+		SELECT id, name, prefix, key_hash, scopes, COALESCE(station_id, 0), created_at, last_used_at, revoked_at
+		FROM api_keys ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*apikey.APIKey, 0)
+	for rows.Next() {
+		k, err := r.scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// MarkUsed records when a key was last used.
+func (r *APIKeyRepository) MarkUsed(ctx context.Context, id string, at time.Time) error {
+	defer observe(r.metrics, "api_keys.mark_used")()
+
+	query := `UPDATE api_keys SET last_used_at = $1 WHERE id = $2`
+	_, err := r.pool.Exec(ctx, query, at, id)
+	return err
+}
+
+// scanAPIKey scans a row into an APIKey entity.
+func (r *APIKeyRepository) scanAPIKey(row pgx.Row) (*apikey.APIKey, error) {
+	var (
+		id, name, prefix, hash string
+		scopes                 []string
+		stationID              int64
+		createdAt              time.Time
+		lastUsedAt, revokedAt  *time.Time
+	)
+
+	err := row.Scan(&id, &name, &prefix, &hash, &scopes, &stationID, &createdAt, &lastUsedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apikey.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return apikey.ReconstructAPIKey(id, name, prefix, hash, scopes, stationID, createdAt, lastUsedAt, revokedAt)
+}
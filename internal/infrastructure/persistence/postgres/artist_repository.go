@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/artist"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ArtistRepository implements artist.Repository by aggregating tracks per artist slug.
+type ArtistRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewArtistRepository creates a new ArtistRepository.
+func NewArtistRepository(pool *pgxpool.Pool, m *metrics.Metrics) *ArtistRepository {
+	return &ArtistRepository{pool: pool, metrics: m}
+}
+
+var _ artist.Repository = (*ArtistRepository)(nil)
+
+// artistColumns selects the artist totals; the displayed name is the most common spelling.
+const artistColumns = `
+	artist_slug,
+	mode() WITHIN GROUP (ORDER BY artist) AS name,
+	COUNT(*) AS tracks,
+	SUM(rotate) AS rotate,
+	SUM(likes) AS likes,
+	SUM(dislikes) AS dislikes,
+	SUM(listeners) AS listeners
+`
+
+// artistSortColumns maps sort fields to the aggregated output columns.
+var artistSortColumns = map[artist.SortField]string{
+	artist.SortByTracks:    "tracks",
+	artist.SortByRotate:    "rotate",
+	artist.SortByLikes:     "likes",
+	artist.SortByDislikes:  "dislikes",
+	artist.SortByListeners: "listeners",
+	artist.SortByName:      "artist_slug",
+}
+
+// FindByCriteria retrieves artists matching the criteria.
+func (r *ArtistRepository) FindByCriteria(ctx context.Context, criteria artist.Criteria) ([]*artist.Artist, error) {
+	defer observe(r.metrics, "artists.find_by_criteria")()
+
+	column, ok := artistSortColumns[criteria.SortBy]
+	if !ok {
+		return nil, artist.ErrInvalidSortField
+	}
+
+	direction := "ASC"
+	if criteria.Descending {
+		direction = "DESC"
+	}
+
+	args := []interface{}{criteria.Limit, criteria.Offset, appshared.StationID(ctx)}
+	where := "WHERE station_id = $3 AND artist_slug != ''"
+	if criteria.Search != "" {
+		args = append(args, "%"+likeEscaper.Replace(criteria.Search)+"%")
+		where += " AND artist ILIKE $4"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tracks
+		%s
+		GROUP BY artist_slug
+		ORDER BY %s %s, artist_slug
+		LIMIT $1 OFFSET $2
+	`, artistColumns, where, column, direction)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	artists := make([]*artist.Artist, 0)
+	for rows.Next() {
+		a, err := r.scanArtist(rows)
+		if err != nil {
+			return nil, err
+		}
+		artists = append(artists, a)
+	}
+	return artists, rows.Err()
+}
+
+// FindBySlug retrieves an artist by its slug.
+func (r *ArtistRepository) FindBySlug(ctx context.Context, slug artist.Slug) (*artist.Artist, error) {
+	defer observe(r.metrics, "artists.find_by_slug")()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tracks
+		WHERE station_id = $1 AND artist_slug = $2
+		GROUP BY artist_slug
+	`, artistColumns)
+
+	return r.scanArtist(r.pool.QueryRow(ctx, query, appshared.StationID(ctx), slug.String()))
+}
+
+// scanArtist scans a row into an Artist read model.
+func (r *ArtistRepository) scanArtist(row pgx.Row) (*artist.Artist, error) {
+	var (
+		slug, name                                 string
+		tracks, rotate, likes, dislikes, listeners int
+	)
+
+	err := row.Scan(&slug, &name, &tracks, &rotate, &likes, &dislikes, &listeners)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, artist.ErrArtistNotFound
+		}
+		return nil, err
+	}
+
+	return artist.ReconstructArtist(slug, name, tracks, rotate, likes, dislikes, listeners), nil
+}
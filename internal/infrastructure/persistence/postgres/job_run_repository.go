@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/infrastructure/metrics"
+	"hub/internal/infrastructure/scheduler/job"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// JobRunRepository implements job.RunStore using PostgreSQL.
+type JobRunRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewJobRunRepository creates a new JobRunRepository.
+func NewJobRunRepository(pool *pgxpool.Pool, m *metrics.Metrics) *JobRunRepository {
+	return &JobRunRepository{pool: pool, metrics: m}
+}
+
+var _ job.RunStore = (*JobRunRepository)(nil)
+
+// Start records the start of a run.
+func (r *JobRunRepository) Start(ctx context.Context, jobName, trigger string, startedAt time.Time) (int64, error) {
+	defer observe(r.metrics, "job_runs.start")()
+
+	query := `
+		INSERT INTO job_runs (job_name, trigger, started_at)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+
+	var id int64
+	err := r.pool.QueryRow(ctx, query, jobName, trigger, startedAt).Scan(&id)
+	return id, err
+}
+
+// Finish records the end of a run.
+func (r *JobRunRepository) Finish(ctx context.Context, id int64, finishedAt time.Time, cause error) error {
+	defer observe(r.metrics, "job_runs.finish")()
+
+	var errMsg *string
+	if cause != nil {
+		msg := cause.Error()
+		errMsg = &msg
+	}
+
+	query := `UPDATE job_runs SET finished_at = $2, error = $3 WHERE id = $1`
+
+	_, err := r.pool.Exec(ctx, query, id, finishedAt, errMsg)
+	return err
+}
+
+// Latest returns the most recent run of each job, one index probe per job.
+func (r *JobRunRepository) Latest(ctx context.Context, jobs []string) (map[string]job.Run, error) {
+	defer observe(r.metrics, "job_runs.latest")()
+
+	query := `
+		SELECT r.id, r.job_name, r.trigger, r.started_at, r.finished_at, COALESCE(r.error, '')
+		FROM unnest($1::text[]) AS j(name)
+		CROSS JOIN LATERAL (
+			SELECT * FROM job_runs WHERE job_name = j.name ORDER BY id DESC LIMIT 1
+		) r
+	`
+
+	rows, err := r.pool.Query(ctx, query, jobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make(map[string]job.Run)
+	for rows.Next() {
+		var run job.Run
+		if err := rows.Scan(&run.ID, &run.Job, &run.Trigger, &run.StartedAt, &run.FinishedAt, &run.Error); err != nil {
+			return nil, err
+		}
+		runs[run.Job] = run
+	}
+
+	return runs, rows.Err()
+}
+
+// Prune deletes runs started before the given time.
+func (r *JobRunRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	defer observe(r.metrics, "job_runs.prune")()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM job_runs WHERE started_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -3,19 +3,22 @@ package postgres
 import (
 	"context"
 
+	appshared "hub/internal/application/shared"
 	"hub/internal/domain/listener"
+	"hub/internal/infrastructure/metrics"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // ListenerRepository handles listener persistence.
 type ListenerRepository struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
 }
 
 // NewListenerRepository creates a new ListenerRepository.
-func NewListenerRepository(pool *pgxpool.Pool) *ListenerRepository {
-	return &ListenerRepository{pool: pool}
+func NewListenerRepository(pool *pgxpool.Pool, m *metrics.Metrics) *ListenerRepository {
+	return &ListenerRepository{pool: pool, metrics: m}
 }
 
 // Ensure ListenerRepository implements listener.Repository
@@ -23,36 +26,44 @@ var _ listener.Repository = (*ListenerRepository)(nil)
 
 // Save persists a listener.
 func (r *ListenerRepository) Save(ctx context.Context, l *listener.Listener) error {
+	defer observe(r.metrics, "listeners.save")()
+
 	query := `
-		INSERT INTO listeners (user_id, track_id, created_at)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (user_id, track_id) DO NOTHING
+		INSERT INTO listeners (station_id, user_id, track_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (station_id, user_id, track_id) DO NOTHING
 	`
 
-	_, err := r.pool.Exec(ctx, query, l.UserID(), l.TrackID(), l.CreatedAt())
+	_, err := r.pool.Exec(ctx, query, appshared.StationID(ctx), l.UserID(), l.TrackID(), l.CreatedAt())
 	return err
 }
 
 // Exists checks if a listener exists.
 func (r *ListenerRepository) Exists(ctx context.Context, userID, trackID string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM listeners WHERE user_id = $1 AND track_id = $2)`
+	defer observe(r.metrics, "listeners.exists")()
+
+	query := `SELECT EXISTS(SELECT 1 FROM listeners WHERE station_id = $1 AND user_id = $2 AND track_id = $3)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, userID, trackID).Scan(&exists)
+	err := r.pool.QueryRow(ctx, query, appshared.StationID(ctx), userID, trackID).Scan(&exists)
 	return exists, err
 }
 
 // CountByTrack returns the unique listener count for a track.
 func (r *ListenerRepository) CountByTrack(ctx context.Context, trackID string) (int, error) {
-	query := `SELECT COUNT(DISTINCT user_id) FROM listeners WHERE track_id = $1`
+	defer observe(r.metrics, "listeners.count_by_track")()
+
+	query := `SELECT COUNT(DISTINCT user_id) FROM listeners WHERE station_id = $1 AND track_id = $2`
 
 	var count int
-	err := r.pool.QueryRow(ctx, query, trackID).Scan(&count)
+	err := r.pool.QueryRow(ctx, query, appshared.StationID(ctx), trackID).Scan(&count)
 	return count, err
 }
 
 // TrackListener tracks a listener for a track (legacy method for adapter).
 func (r *ListenerRepository) TrackListener(ctx context.Context, userID, trackID string) error {
+	defer observe(r.metrics, "listeners.track_listener")()
+
 	l, err := listener.NewListener(userID, trackID)
 	if err != nil {
 		return err
@@ -62,5 +73,7 @@ func (r *ListenerRepository) TrackListener(ctx context.Context, userID, trackID
 
 // GetUniqueListenerCount returns the number of unique listeners for a track (legacy).
 func (r *ListenerRepository) GetUniqueListenerCount(ctx context.Context, trackID string) (int, error) {
+	defer observe(r.metrics, "listeners.get_unique_listener_count")()
+
 	return r.CountByTrack(ctx, trackID)
 }
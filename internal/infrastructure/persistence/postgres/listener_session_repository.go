@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	appplay "hub/internal/application/play"
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/listener"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ListenerSessionRepository implements listener.SessionRepository using PostgreSQL.
+type ListenerSessionRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewListenerSessionRepository creates a new ListenerSessionRepository.
+func NewListenerSessionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *ListenerSessionRepository {
+	return &ListenerSessionRepository{pool: pool, metrics: m}
+}
+
+var (
+	_ listener.SessionRepository = (*ListenerSessionRepository)(nil)
+	_ appplay.AudienceCounter    = (*ListenerSessionRepository)(nil)
+)
+
+// FindOpen returns every session of the station that has not ended.
+func (r *ListenerSessionRepository) FindOpen(ctx context.Context) ([]*listener.Session, error) {
+	defer observe(r.metrics, "listener_sessions.find_open")()
+
+	query := `
+		SELECT id, user_id, client_id, started_at, last_seen_at,
+			COALESCE(first_track_id, ''), COALESCE(last_track_id, '')
+		FROM listener_sessions
+		WHERE station_id = $1 AND ended_at IS NULL
+	`
+
+	rows, err := r.pool.Query(ctx, query, appshared.StationID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*listener.Session
+	for rows.Next() {
+		var (
+			id                        int64
+			userID                    string
+			clientID                  int
+			startedAt, lastSeenAt     time.Time
+			firstTrackID, lastTrackID string
+		)
+		if err := rows.Scan(&id, &userID, &clientID, &startedAt, &lastSeenAt, &firstTrackID, &lastTrackID); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, listener.ReconstructSession(id, userID, clientID, startedAt, lastSeenAt, nil, firstTrackID, lastTrackID))
+	}
+
+	return sessions, rows.Err()
+}
+
+// Open inserts a session, unless the listener already has one open on the
+// station, and records the track heard, if any.
+func (r *ListenerSessionRepository) Open(ctx context.Context, s *listener.Session) error {
+	defer observe(r.metrics, "listener_sessions.open")()
+
+	query := `
+		WITH session AS (
+			INSERT INTO listener_sessions (user_id, client_id, started_at, last_seen_at, first_track_id, last_track_id, station_id)
+			VALUES ($1, $2, $3, $4, NULLIF($5::text, ''), NULLIF($6::text, ''), $7)
+			ON CONFLICT (station_id, user_id) WHERE ended_at IS NULL DO NOTHING
+			RETURNING id, station_id
+		)
+		INSERT INTO listener_session_tracks (session_id, track_id, heard_at, station_id)
+		SELECT id, $6::text, $4, station_id FROM session WHERE $6::text <> ''
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		s.UserID(), s.ClientID(), s.StartedAt(), s.LastSeenAt(), s.FirstTrackID(), s.LastTrackID(), appshared.StationID(ctx),
+	)
+	return err
+}
+
+// Extend marks the sessions as seen and records the track heard, if any.
+func (r *ListenerSessionRepository) Extend(ctx context.Context, ids []int64, seenAt time.Time, trackID string) error {
+	defer observe(r.metrics, "listener_sessions.extend")()
+
+	query := `
+		WITH extended AS (
+			UPDATE listener_sessions
+			SET last_seen_at = $2, last_track_id = COALESCE(NULLIF($3::text, ''), last_track_id)
+			WHERE id = ANY($1) AND ended_at IS NULL
+			RETURNING id, station_id
+		)
+		INSERT INTO listener_session_tracks (session_id, track_id, heard_at, station_id)
+		SELECT id, $3::text, $2, station_id FROM extended WHERE $3::text <> ''
+		ON CONFLICT (session_id, track_id) DO NOTHING
+	`
+
+	_, err := r.pool.Exec(ctx, query, ids, seenAt, trackID)
+	return err
+}
+
+// Close persists the end and duration of a session.
+func (r *ListenerSessionRepository) Close(ctx context.Context, s *listener.Session) error {
+	defer observe(r.metrics, "listener_sessions.close")()
+
+	query := `
+		UPDATE listener_sessions
+		SET ended_at = $2, duration_seconds = $3
+		WHERE id = $1 AND ended_at IS NULL
+	`
+
+	_, err := r.pool.Exec(ctx, query, s.ID(), s.EndedAt(), int(s.Duration().Seconds()))
+	return err
+}
+
+// CountOpen counts the sessions of the station open at a time.
+func (r *ListenerSessionRepository) CountOpen(ctx context.Context, at time.Time) (int, error) {
+	defer observe(r.metrics, "listener_sessions.count_open")()
+
+	query := `
+		SELECT COUNT(*) FROM listener_sessions
+		WHERE station_id = $2 AND started_at <= $1 AND (ended_at IS NULL OR ended_at > $1)
+	`
+
+	var n int
+	err := r.pool.QueryRow(ctx, query, at, appshared.StationID(ctx)).Scan(&n)
+	return n, err
+}
+
+// CountJoined counts the sessions of the station started within [from, to).
+func (r *ListenerSessionRepository) CountJoined(ctx context.Context, from, to time.Time) (int, error) {
+	defer observe(r.metrics, "listener_sessions.count_joined")()
+
+	query := `SELECT COUNT(*) FROM listener_sessions WHERE station_id = $3 AND started_at >= $1 AND started_at < $2`
+
+	var n int
+	err := r.pool.QueryRow(ctx, query, from, to, appshared.StationID(ctx)).Scan(&n)
+	return n, err
+}
+
+// CountLeft counts the sessions of the station open at openAt that ended
+// within [from, to).
+func (r *ListenerSessionRepository) CountLeft(ctx context.Context, openAt, from, to time.Time) (int, error) {
+	defer observe(r.metrics, "listener_sessions.count_left")()
+
+	query := `
+		SELECT COUNT(*) FROM listener_sessions
+		WHERE station_id = $4 AND started_at <= $1 AND ended_at >= $2 AND ended_at < $3
+	`
+
+	var n int
+	err := r.pool.QueryRow(ctx, query, openAt, from, to, appshared.StationID(ctx)).Scan(&n)
+	return n, err
+}
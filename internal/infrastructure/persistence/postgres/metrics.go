@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"time"
+
+	"hub/internal/infrastructure/metrics"
+)
+
+// observe starts timing a repository operation and returns the function that
+// records it, so a method can time itself with defer observe(r.metrics, "tracks.save")().
+func observe(m *metrics.Metrics, operation string) func() {
+	start := time.Now()
+	return func() {
+		if m != nil {
+			m.RecordDBQuery(operation, time.Since(start))
+		}
+	}
+}
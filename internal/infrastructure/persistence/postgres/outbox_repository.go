@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hub/internal/infrastructure/metrics"
+	"hub/internal/infrastructure/outbox"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OutboxRepository implements outbox.Store using PostgreSQL.
+type OutboxRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewOutboxRepository creates a new OutboxRepository.
+func NewOutboxRepository(pool *pgxpool.Pool, m *metrics.Metrics) *OutboxRepository {
+	return &OutboxRepository{pool: pool, metrics: m}
+}
+
+var _ outbox.Store = (*OutboxRepository)(nil)
+
+// Append stores an event in the transaction carried by ctx, if any.
+func (r *OutboxRepository) Append(ctx context.Context, msg outbox.Message) error {
+	defer observe(r.metrics, "outbox_events.append")()
+
+	query := `
+		INSERT INTO outbox_events (event_name, correlation_id, payload, occurred_at, station_id)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5)
+	`
+
+	_, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query, msg.EventName, msg.CorrelationID, msg.Payload, msg.OccurredAt, msg.StationID)
+	return err
+}
+
+// Claim leases due pending events. SKIP LOCKED lets several relays claim concurrently.
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Message, error) {
+	defer observe(r.metrics, "outbox_events.claim")()
+
+	query := `
+		UPDATE outbox_events SET next_attempt_at = NOW() + $2::bigint * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_name, COALESCE(correlation_id, ''), station_id, payload, occurred_at, attempts, delivered_to, next_attempt_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []outbox.Message
+	for rows.Next() {
+		var m outbox.Message
+		if err := rows.Scan(&m.ID, &m.EventName, &m.CorrelationID, &m.StationID, &m.Payload, &m.OccurredAt, &m.Attempts, &m.Delivered, &m.LeasedUntil); err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	return messages, rows.Err()
+}
+
+// Renew extends a lease if it is still the one the message was claimed or
+// last renewed with.
+func (r *OutboxRepository) Renew(ctx context.Context, msg *outbox.Message, lease time.Duration) (bool, error) {
+	defer observe(r.metrics, "outbox_events.renew")()
+
+	query := `
+		UPDATE outbox_events SET next_attempt_at = NOW() + $3::bigint * INTERVAL '1 millisecond'
+		WHERE id = $1 AND status = 'pending' AND next_attempt_at = $2
+		RETURNING next_attempt_at
+	`
+
+	err := r.pool.QueryRow(ctx, query, msg.ID, msg.LeasedUntil, lease.Milliseconds()).Scan(&msg.LeasedUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MarkDelivered records a successful delivery.
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64) error {
+	defer observe(r.metrics, "outbox_events.mark_delivered")()
+
+	query := `
+		UPDATE outbox_events SET status = 'delivered', delivered_at = NOW(), last_error = NULL
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id)
+	return err
+}
+
+// MarkFailed records a failed attempt and schedules the retry or dead-letters the event.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, delivered []string, cause error, nextAttempt time.Time, dead bool) error {
+	defer observe(r.metrics, "outbox_events.mark_failed")()
+
+	status := "pending"
+	if dead {
+		status = "dead"
+	}
+
+	query := `
+		UPDATE outbox_events
+		SET status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = $4, delivered_to = $5
+		WHERE id = $1
+	`
+
+	if delivered == nil {
+		delivered = []string{}
+	}
+	_, err := r.pool.Exec(ctx, query, id, status, cause.Error(), nextAttempt, delivered)
+	return err
+}
+
+// Prune deletes events delivered before the given time. Dead events are
+// kept for inspection.
+func (r *OutboxRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	defer observe(r.metrics, "outbox_events.prune")()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM outbox_events WHERE status = 'delivered' AND delivered_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	appplay "hub/internal/application/play"
+	appshared "hub/internal/application/shared"
+	apptrack "hub/internal/application/track"
+	"hub/internal/domain/play"
+	"hub/internal/domain/track"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PlayRepository implements play.Repository using PostgreSQL.
+type PlayRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewPlayRepository creates a new PlayRepository.
+func NewPlayRepository(pool *pgxpool.Pool, m *metrics.Metrics) *PlayRepository {
+	return &PlayRepository{pool: pool, metrics: m}
+}
+
+var (
+	_ play.Repository        = (*PlayRepository)(nil)
+	_ appplay.HistoryReader  = (*PlayRepository)(nil)
+	_ apptrack.TuneOutReader = (*PlayRepository)(nil)
+)
+
+// Save persists a play in the station the context is scoped to.
+// Only the end data of an existing play is updated.
+func (r *PlayRepository) Save(ctx context.Context, p *play.Play) error {
+	defer observe(r.metrics, "plays.save")()
+
+	query := `
+		INSERT INTO plays (id, track_id, started_at, ended_at, listeners_start, listeners_end, listeners_present, listeners_joined, listeners_tuned_out, station_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			ended_at = EXCLUDED.ended_at,
+			listeners_end = EXCLUDED.listeners_end,
+			listeners_present = EXCLUDED.listeners_present,
+			listeners_joined = EXCLUDED.listeners_joined,
+			listeners_tuned_out = EXCLUDED.listeners_tuned_out
+	`
+
+	_, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query,
+		p.ID(),
+		p.TrackID().String(),
+		p.StartedAt(),
+		p.EndedAt(),
+		p.ListenersStart(),
+		p.ListenersEnd(),
+		p.ListenersPresent(),
+		p.ListenersJoined(),
+		p.ListenersTunedOut(),
+		appshared.StationID(ctx),
+	)
+	return err
+}
+
+// FindCurrent retrieves the play that is currently on air.
+func (r *PlayRepository) FindCurrent(ctx context.Context) (*play.Play, error) {
+	defer observe(r.metrics, "plays.find_current")()
+	return r.findCurrent(ctx, "")
+}
+
+// FindCurrentForUpdate retrieves the play on air and locks its row until
+// the transaction carried by ctx ends.
+func (r *PlayRepository) FindCurrentForUpdate(ctx context.Context) (*play.Play, error) {
+	defer observe(r.metrics, "plays.find_current_for_update")()
+	return r.findCurrent(ctx, "FOR UPDATE")
+}
+
+func (r *PlayRepository) findCurrent(ctx context.Context, lock string) (*play.Play, error) {
+	query := `
+		SELECT id, track_id, started_at, ended_at, listeners_start, listeners_end, listeners_present, listeners_joined, listeners_tuned_out
+		FROM plays WHERE station_id = $1 AND ended_at IS NULL
+		ORDER BY started_at DESC LIMIT 1
+	` + lock
+
+	var (
+		id, trackID    string
+		startedAt      time.Time
+		endedAt        *time.Time
+		listenersStart int
+		listenersEnd   *int
+		present        *int
+		joined         *int
+		tunedOut       *int
+	)
+
+	err := GetTxOrPool(ctx, r.pool).QueryRow(ctx, query, appshared.StationID(ctx)).
+		Scan(&id, &trackID, &startedAt, &endedAt, &listenersStart, &listenersEnd, &present, &joined, &tunedOut)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, play.ErrPlayNotFound
+		}
+		return nil, err
+	}
+
+	return play.ReconstructPlay(id, trackID, startedAt, endedAt, listenersStart, listenersEnd, present, joined, tunedOut)
+}
+
+// FindHistory returns plays joined with their tracks, newest first.
+func (r *PlayRepository) FindHistory(ctx context.Context, criteria appplay.HistoryCriteria) ([]*appplay.PlayDTO, error) {
+	defer observe(r.metrics, "plays.find_history")()
+
+	conditions := []string{"p.station_id = $1"}
+	args := []interface{}{appshared.StationID(ctx)}
+
+	if criteria.From != nil {
+		args = append(args, *criteria.From)
+		conditions = append(conditions, fmt.Sprintf("p.started_at >= $%d", len(args)))
+	}
+	if criteria.To != nil {
+		args = append(args, *criteria.To)
+		conditions = append(conditions, fmt.Sprintf("p.started_at < $%d", len(args)))
+	}
+	if criteria.BeforeStartedAt != nil {
+		args = append(args, *criteria.BeforeStartedAt, criteria.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("(p.started_at, p.id) < ($%d, $%d::uuid)", len(args)-1, len(args)))
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+
+	args = append(args, criteria.Limit)
+	query := fmt.Sprintf(`
+		SELECT p.id, p.track_id, t.title, t.cover, p.started_at, p.ended_at, p.listeners_start, p.listeners_end
+		FROM plays p JOIN tracks t ON t.station_id = p.station_id AND t.id = p.track_id
+		%s
+		ORDER BY p.started_at DESC, p.id DESC
+		LIMIT $%d
+	`, where, len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plays := make([]*appplay.PlayDTO, 0)
+	for rows.Next() {
+		var p appplay.PlayDTO
+		if err := rows.Scan(&p.ID, &p.TrackID, &p.Title, &p.Cover, &p.StartedAt, &p.EndedAt, &p.ListenersStart, &p.ListenersEnd); err != nil {
+			return nil, err
+		}
+		plays = append(plays, &p)
+	}
+	return plays, rows.Err()
+}
+
+// TuneOutRate returns the share of listener sessions open at the start of
+// the track's plays that ended early, over the plays with a known audience.
+func (r *PlayRepository) TuneOutRate(ctx context.Context, id track.TrackID) (*float64, error) {
+	defer observe(r.metrics, "plays.tune_out_rate")()
+
+	query := `
+		SELECT SUM(listeners_tuned_out)::float8 / NULLIF(SUM(listeners_present), 0)
+		FROM plays
+		WHERE station_id = $1 AND track_id = $2 AND listeners_present IS NOT NULL
+	`
+
+	var rate *float64
+	if err := r.pool.QueryRow(ctx, query, appshared.StationID(ctx), id.String()).Scan(&rate); err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"time"
 
+	appshared "hub/internal/application/shared"
 	"hub/internal/domain/reaction"
 	"hub/internal/domain/track"
+	"hub/internal/infrastructure/metrics"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,18 +16,21 @@ import (
 
 // ReactionRepository implements reaction.Repository using PostgreSQL.
 type ReactionRepository struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
 }
 
 // NewReactionRepository creates a new ReactionRepository.
-func NewReactionRepository(pool *pgxpool.Pool) *ReactionRepository {
-	return &ReactionRepository{pool: pool}
+func NewReactionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *ReactionRepository {
+	return &ReactionRepository{pool: pool, metrics: m}
 }
 
 // Save persists a reaction atomically with the track counter update.
 func (r *ReactionRepository) Save(ctx context.Context, react *reaction.Reaction) error {
+	defer observe(r.metrics, "reactions.save")()
+
 	// Start transaction
-	tx, err := r.pool.Begin(ctx)
+	tx, err := BeginTx(ctx, r.pool)
 	if err != nil {
 		return err
 	}
@@ -33,12 +38,13 @@ func (r *ReactionRepository) Save(ctx context.Context, react *reaction.Reaction)
 
 	// Insert with ON CONFLICT DO NOTHING to handle concurrent inserts gracefully
 	query := `
-		INSERT INTO reactions (user_id, track_id, reaction, created_at)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id, track_id) DO NOTHING
+		INSERT INTO reactions (station_id, user_id, track_id, reaction, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (station_id, user_id, track_id) DO NOTHING
 	`
 
 	result, err := tx.Exec(ctx, query,
+		appshared.StationID(ctx),
 		react.UserID().String(),
 		react.TrackID().String(),
 		react.ReactionType().String(),
@@ -54,28 +60,155 @@ func (r *ReactionRepository) Save(ctx context.Context, react *reaction.Reaction)
 		return reaction.ErrReactionExists
 	}
 
-	// Update track likes/dislikes count within same transaction
-	var updateQuery string
-	if react.IsLike() {
-		updateQuery = `UPDATE tracks SET likes = likes + 1, updated_at = NOW() WHERE id = $1`
-	} else {
-		updateQuery = `UPDATE tracks SET dislikes = dislikes + 1, updated_at = NOW() WHERE id = $1`
+	// Update track reaction counters within same transaction
+	if err := r.adjustCounters(ctx, tx, react.TrackID(), react.ReactionType(), 1); err != nil {
+		return err
 	}
 
-	_, err = tx.Exec(ctx, updateQuery, react.TrackID().String())
+	// Commit transaction
+	return tx.Commit(ctx)
+}
+
+// Update switches a reaction's type and moves the track counter atomically.
+func (r *ReactionRepository) Update(ctx context.Context, react *reaction.Reaction, previous reaction.ReactionType) error {
+	defer observe(r.metrics, "reactions.update")()
+
+	tx, err := BeginTx(ctx, r.pool)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback(ctx)
+
+	// Matching on the previous type guards against a concurrent change or retraction.
+	// A switched reaction counts as new in the time-window queries
+	query := `
+		UPDATE reactions SET reaction = $1, created_at = $2
+		WHERE station_id = $3 AND user_id = $4 AND track_id = $5 AND reaction = $6
+	`
+
+	result, err := tx.Exec(ctx, query,
+		react.ReactionType().String(),
+		react.CreatedAt(),
+		appshared.StationID(ctx),
+		react.UserID().String(),
+		react.TrackID().String(),
+		previous.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return reaction.ErrReactionNotFound
+	}
+
+	if err := r.adjustCounters(ctx, tx, react.TrackID(), previous, -1); err != nil {
+		return err
+	}
+	if err := r.adjustCounters(ctx, tx, react.TrackID(), react.ReactionType(), 1); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete removes a reaction and decrements the track counter atomically.
+func (r *ReactionRepository) Delete(ctx context.Context, react *reaction.Reaction) error {
+	defer observe(r.metrics, "reactions.delete")()
+
+	tx, err := BeginTx(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		DELETE FROM reactions
+		WHERE station_id = $1 AND user_id = $2 AND track_id = $3 AND reaction = $4
+	`
+
+	result, err := tx.Exec(ctx, query,
+		appshared.StationID(ctx),
+		react.UserID().String(),
+		react.TrackID().String(),
+		react.ReactionType().String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return reaction.ErrReactionNotFound
+	}
+
+	if err := r.adjustCounters(ctx, tx, react.TrackID(), react.ReactionType(), -1); err != nil {
+		return err
+	}
 
-	// Commit transaction
 	return tx.Commit(ctx)
 }
 
+// adjustCounters moves the per-type track counter by delta.
+// Likes and dislikes are also mirrored on the tracks row, which statistics rank by.
+func (r *ReactionRepository) adjustCounters(ctx context.Context, tx pgx.Tx, trackID track.TrackID, reactionType reaction.ReactionType, delta int) error {
+	stationID := appshared.StationID(ctx)
+
+	query := `
+		INSERT INTO track_reaction_counts (station_id, track_id, reaction, count)
+		VALUES ($1, $2, $3, GREATEST($4, 0))
+		ON CONFLICT (station_id, track_id, reaction) DO UPDATE SET
+			count = GREATEST(track_reaction_counts.count + $4, 0)
+	`
+	if _, err := tx.Exec(ctx, query, stationID, trackID.String(), reactionType.String(), delta); err != nil {
+		return err
+	}
+
+	switch {
+	case reactionType.IsLike():
+		_, err := tx.Exec(ctx, `UPDATE tracks SET likes = GREATEST(likes + $1, 0), updated_at = NOW() WHERE station_id = $2 AND id = $3`, delta, stationID, trackID.String())
+		return err
+	case reactionType.IsDislike():
+		_, err := tx.Exec(ctx, `UPDATE tracks SET dislikes = GREATEST(dislikes + $1, 0), updated_at = NOW() WHERE station_id = $2 AND id = $3`, delta, stationID, trackID.String())
+		return err
+	default:
+		_, err := tx.Exec(ctx, `UPDATE tracks SET updated_at = NOW() WHERE station_id = $1 AND id = $2`, stationID, trackID.String())
+		return err
+	}
+}
+
+// CountByTrack returns the reaction counters of a track keyed by reaction type.
+func (r *ReactionRepository) CountByTrack(ctx context.Context, trackID track.TrackID) (map[string]int, error) {
+	defer observe(r.metrics, "reactions.count_by_track")()
+
+	query := `SELECT reaction, count FROM track_reaction_counts WHERE station_id = $1 AND track_id = $2 AND count > 0`
+
+	rows, err := GetTxOrPool(ctx, r.pool).Query(ctx, query, appshared.StationID(ctx), trackID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			reactionType string
+			count        int
+		)
+		if err := rows.Scan(&reactionType, &count); err != nil {
+			return nil, err
+		}
+		counts[reactionType] = count
+	}
+	return counts, rows.Err()
+}
+
 // FindByUserAndTrack retrieves a reaction by user and track.
 func (r *ReactionRepository) FindByUserAndTrack(ctx context.Context, userID reaction.UserID, trackID track.TrackID) (*reaction.Reaction, error) {
+	defer observe(r.metrics, "reactions.find_by_user_and_track")()
+
 	query := `
 		SELECT id, user_id, track_id, reaction, created_at
-		FROM reactions WHERE user_id = $1 AND track_id = $2
+		FROM reactions WHERE station_id = $1 AND user_id = $2 AND track_id = $3
 	`
 
 	var (
@@ -84,7 +217,7 @@ func (r *ReactionRepository) FindByUserAndTrack(ctx context.Context, userID reac
 		createdAt                        time.Time
 	)
 
-	err := r.pool.QueryRow(ctx, query, userID.String(), trackID.String()).
+	err := GetTxOrPool(ctx, r.pool).QueryRow(ctx, query, appshared.StationID(ctx), userID.String(), trackID.String()).
 		Scan(&id, &userIDStr, &trackIDStr, &reactType, &createdAt)
 
 	if err != nil {
@@ -99,10 +232,12 @@ func (r *ReactionRepository) FindByUserAndTrack(ctx context.Context, userID reac
 
 // Exists checks if a reaction exists for the given user and track.
 func (r *ReactionRepository) Exists(ctx context.Context, userID reaction.UserID, trackID track.TrackID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM reactions WHERE user_id = $1 AND track_id = $2)`
+	defer observe(r.metrics, "reactions.exists")()
+
+	query := `SELECT EXISTS(SELECT 1 FROM reactions WHERE station_id = $1 AND user_id = $2 AND track_id = $3)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, userID.String(), trackID.String()).Scan(&exists)
+	err := GetTxOrPool(ctx, r.pool).QueryRow(ctx, query, appshared.StationID(ctx), userID.String(), trackID.String()).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
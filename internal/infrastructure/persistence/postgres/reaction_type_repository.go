@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hub/internal/domain/reaction"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ReactionTypeRepository implements reaction.TypeRepository using PostgreSQL.
+type ReactionTypeRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewReactionTypeRepository creates a new ReactionTypeRepository.
+func NewReactionTypeRepository(pool *pgxpool.Pool, m *metrics.Metrics) *ReactionTypeRepository {
+	return &ReactionTypeRepository{pool: pool, metrics: m}
+}
+
+var _ reaction.TypeRepository = (*ReactionTypeRepository)(nil)
+
+// FindAll retrieves every reaction type in display order.
+func (r *ReactionTypeRepository) FindAll(ctx context.Context) ([]*reaction.Definition, error) {
+	defer observe(r.metrics, "reaction_types.find_all")()
+
+	query := `
+		SELECT key, emoji, label, position, enabled
+		FROM reaction_types ORDER BY position, key
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	definitions := make([]*reaction.Definition, 0)
+	for rows.Next() {
+		def, err := r.scanDefinition(rows)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, def)
+	}
+	return definitions, rows.Err()
+}
+
+// FindByType retrieves the definition of a reaction type.
+func (r *ReactionTypeRepository) FindByType(ctx context.Context, reactionType reaction.ReactionType) (*reaction.Definition, error) {
+	defer observe(r.metrics, "reaction_types.find_by_type")()
+
+	query := `
+		SELECT key, emoji, label, position, enabled
+		FROM reaction_types WHERE key = $1
+	`
+
+	return r.scanDefinition(r.pool.QueryRow(ctx, query, reactionType.String()))
+}
+
+// scanDefinition scans a row into a Definition.
+func (r *ReactionTypeRepository) scanDefinition(row pgx.Row) (*reaction.Definition, error) {
+	var (
+		key, emoji, label string
+		position          int
+		enabled           bool
+	)
+
+	if err := row.Scan(&key, &emoji, &label, &position, &enabled); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, reaction.ErrInvalidReactionType
+		}
+		return nil, err
+	}
+
+	return reaction.ReconstructDefinition(key, emoji, label, position, enabled)
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"hub/internal/domain/station"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StationRepository implements station.Repository using PostgreSQL.
+type StationRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewStationRepository creates a new StationRepository.
+func NewStationRepository(pool *pgxpool.Pool, m *metrics.Metrics) *StationRepository {
+	return &StationRepository{pool: pool, metrics: m}
+}
+
+var _ station.Repository = (*StationRepository)(nil)
+
+// Save creates the station or renames the existing one with the same slug.
+func (r *StationRepository) Save(ctx context.Context, s *station.Station) (*station.Station, error) {
+	defer observe(r.metrics, "stations.save")()
+
+	query := `
+		INSERT INTO stations (slug, name) VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+
+	var id int64
+	if err := r.pool.QueryRow(ctx, query, s.Slug(), s.Name()).Scan(&id); err != nil {
+		return nil, err
+	}
+	return station.ReconstructStation(id, s.Slug(), s.Name()), nil
+}
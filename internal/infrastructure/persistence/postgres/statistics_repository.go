@@ -2,61 +2,248 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	appshared "hub/internal/application/shared"
 	"hub/internal/application/statistics"
+	"hub/internal/infrastructure/metrics"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // StatisticsRepository implements statistics.Repository.
+// All-time statistics read the counters on tracks; windowed statistics
+// aggregate the timestamped source rows (plays, listeners, reactions).
+// Every query is restricted to the station the context is scoped to.
 type StatisticsRepository struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
 }
 
 // NewStatisticsRepository creates a new StatisticsRepository.
-func NewStatisticsRepository(pool *pgxpool.Pool) *StatisticsRepository {
-	return &StatisticsRepository{pool: pool}
+func NewStatisticsRepository(pool *pgxpool.Pool, m *metrics.Metrics) *StatisticsRepository {
+	return &StatisticsRepository{pool: pool, metrics: m}
 }
 
 var _ statistics.Repository = (*StatisticsRepository)(nil)
 
-func (r *StatisticsRepository) GetHistory(ctx context.Context) ([]*statistics.TrackStats, error) {
-	return r.queryTracks(ctx, `
-		SELECT title, cover, rotate, likes, dislikes, listeners
-		FROM tracks ORDER BY created_at DESC LIMIT 5
-	`)
+func (r *StatisticsRepository) GetHistory(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_history")()
+
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, 0
+		FROM plays p JOIN tracks t ON t.station_id = p.station_id AND t.id = p.track_id
+		WHERE p.station_id = $5 AND %s
+		ORDER BY p.started_at DESC, p.id DESC LIMIT $3 OFFSET $4
+	`, windowCondition("p.started_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
 }
 
-func (r *StatisticsRepository) GetTopListened(ctx context.Context) ([]*statistics.TrackStats, error) {
-	return r.queryTracks(ctx, `
-		SELECT title, cover, rotate, likes, dislikes, listeners
-		FROM tracks WHERE listeners > 0 ORDER BY listeners DESC LIMIT 5
-	`)
+func (r *StatisticsRepository) GetTopListened(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_listened")()
+
+	if page.Window.IsAllTime() {
+		return r.queryTracks(ctx, `
+			SELECT title, artist, song, cover, rotate, likes, dislikes, listeners, listeners
+			FROM tracks WHERE station_id = $3 AND listeners > 0 ORDER BY listeners DESC, id LIMIT $1 OFFSET $2
+		`, page.Limit, page.Offset, appshared.StationID(ctx))
+	}
+
+	// A listeners row is written the first time a user is heard on a track,
+	// so this counts unique listeners first heard within the window.
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, COUNT(DISTINCT l.user_id) AS n
+		FROM listeners l JOIN tracks t ON t.station_id = l.station_id AND t.id = l.track_id
+		WHERE l.station_id = $5 AND %s
+		GROUP BY t.station_id, t.id ORDER BY n DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("l.created_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
 }
 
-func (r *StatisticsRepository) GetTopRotate(ctx context.Context) ([]*statistics.TrackStats, error) {
-	return r.queryTracks(ctx, `
-		SELECT title, cover, rotate, likes, dislikes, listeners
-		FROM tracks ORDER BY rotate DESC LIMIT 5
-	`)
+func (r *StatisticsRepository) GetTopRotate(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_rotate")()
+
+	if page.Window.IsAllTime() {
+		return r.queryTracks(ctx, `
+			SELECT title, artist, song, cover, rotate, likes, dislikes, listeners, rotate
+			FROM tracks WHERE station_id = $3 ORDER BY rotate DESC, id LIMIT $1 OFFSET $2
+		`, page.Limit, page.Offset, appshared.StationID(ctx))
+	}
+
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, COUNT(*) AS n
+		FROM plays p JOIN tracks t ON t.station_id = p.station_id AND t.id = p.track_id
+		WHERE p.station_id = $5 AND %s
+		GROUP BY t.station_id, t.id ORDER BY n DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("p.started_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
 }
 
-func (r *StatisticsRepository) GetTopLikes(ctx context.Context) ([]*statistics.TrackStats, error) {
-	return r.queryTracks(ctx, `
-		SELECT title, cover, rotate, likes, dislikes, listeners
-		FROM tracks WHERE likes > 0 ORDER BY likes DESC LIMIT 5
-	`)
+func (r *StatisticsRepository) GetTopLikes(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_likes")()
+
+	if page.Window.IsAllTime() {
+		return r.queryTracks(ctx, `
+			SELECT title, artist, song, cover, rotate, likes, dislikes, listeners, likes
+			FROM tracks WHERE station_id = $3 AND likes > 0 ORDER BY likes DESC, id LIMIT $1 OFFSET $2
+		`, page.Limit, page.Offset, appshared.StationID(ctx))
+	}
+
+	return r.queryTopReactions(ctx, "like", page)
 }
 
-func (r *StatisticsRepository) GetTopDislikes(ctx context.Context) ([]*statistics.TrackStats, error) {
-	return r.queryTracks(ctx, `
-		SELECT title, cover, rotate, likes, dislikes, listeners
-		FROM tracks WHERE dislikes > 0 ORDER BY dislikes DESC LIMIT 5
-	`)
+func (r *StatisticsRepository) GetTopDislikes(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_dislikes")()
+
+	if page.Window.IsAllTime() {
+		return r.queryTracks(ctx, `
+			SELECT title, artist, song, cover, rotate, likes, dislikes, listeners, dislikes
+			FROM tracks WHERE station_id = $3 AND dislikes > 0 ORDER BY dislikes DESC, id LIMIT $1 OFFSET $2
+		`, page.Limit, page.Offset, appshared.StationID(ctx))
+	}
+
+	return r.queryTopReactions(ctx, "dislike", page)
 }
 
-func (r *StatisticsRepository) queryTracks(ctx context.Context, query string) ([]*statistics.TrackStats, error) {
-	rows, err := r.pool.Query(ctx, query)
+// GetTopArtists ranks artists by plays, summing their tracks' lifetime counters.
+// Each row is an artist: Title and Artist carry the name, Cover the cover of
+// their most rotated track.
+func (r *StatisticsRepository) GetTopArtists(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_artists")()
+
+	if page.Window.IsAllTime() {
+		return r.queryArtists(ctx, fmt.Sprintf(`
+			SELECT %s, SUM(t.rotate) AS n
+			FROM tracks t WHERE t.station_id = $3 AND t.artist_slug != ''
+			GROUP BY t.artist_slug ORDER BY n DESC, t.artist_slug LIMIT $1 OFFSET $2
+		`, artistStatsColumns), page.Limit, page.Offset, appshared.StationID(ctx))
+	}
+
+	return r.queryArtists(ctx, fmt.Sprintf(`
+		WITH played AS (
+			SELECT t.artist_slug, COUNT(*) AS n
+			FROM plays p JOIN tracks t ON t.station_id = p.station_id AND t.id = p.track_id
+			WHERE p.station_id = $5 AND t.artist_slug != '' AND %s
+			GROUP BY t.artist_slug
+		)
+		SELECT %s, played.n
+		FROM played JOIN tracks t ON t.station_id = $5 AND t.artist_slug = played.artist_slug
+		GROUP BY t.artist_slug, played.n ORDER BY played.n DESC, t.artist_slug LIMIT $3 OFFSET $4
+	`, windowCondition("p.started_at"), artistStatsColumns), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
+}
+
+// GetTopTuneIns ranks tracks by listener sessions that started on them.
+// Sessions of listeners already connected when first polled don't count.
+func (r *StatisticsRepository) GetTopTuneIns(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_tune_ins")()
+
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, COUNT(*) AS n
+		FROM listener_sessions s JOIN tracks t ON t.station_id = s.station_id AND t.id = s.first_track_id
+		WHERE s.station_id = $5 AND %s
+		GROUP BY t.station_id, t.id ORDER BY n DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("s.started_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
+}
+
+// GetTopTuneOuts ranks tracks by listener sessions that ended on them.
+func (r *StatisticsRepository) GetTopTuneOuts(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_tune_outs")()
+
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, COUNT(*) AS n
+		FROM listener_sessions s JOIN tracks t ON t.station_id = s.station_id AND t.id = s.last_track_id
+		WHERE s.station_id = $5 AND s.ended_at IS NOT NULL AND %s
+		GROUP BY t.station_id, t.id ORDER BY n DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("s.ended_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
+}
+
+// GetTopTuneOutRate ranks tracks by the share of listener sessions open at
+// the start of their plays that ended early. The count is that share as a
+// percentage.
+func (r *StatisticsRepository) GetTopTuneOutRate(ctx context.Context, page statistics.Page) ([]*statistics.TrackStats, error) {
+	defer observe(r.metrics, "statistics.get_top_tune_out_rate")()
+
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners,
+			ROUND(100.0 * SUM(p.listeners_tuned_out) / SUM(p.listeners_present))::int AS n
+		FROM plays p JOIN tracks t ON t.station_id = p.station_id AND t.id = p.track_id
+		WHERE p.station_id = $5 AND p.listeners_present IS NOT NULL AND %s
+		GROUP BY t.station_id, t.id HAVING SUM(p.listeners_present) > 0
+		ORDER BY n DESC, SUM(p.listeners_tuned_out) DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("p.started_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, appshared.StationID(ctx))
+}
+
+// GetListening summarizes listener sessions started within the window.
+func (r *StatisticsRepository) GetListening(ctx context.Context, window statistics.Window) (*statistics.Listening, error) {
+	defer observe(r.metrics, "statistics.get_listening")()
+
+	summary := fmt.Sprintf(`
+		SELECT COUNT(*),
+			COALESCE(AVG(s.duration_seconds), 0)::float8,
+			COALESCE(SUM(EXTRACT(EPOCH FROM COALESCE(s.ended_at, s.last_seen_at) - s.started_at)), 0)::float8 / 3600
+		FROM listener_sessions s
+		WHERE s.station_id = $3 AND %s
+	`, windowCondition("s.started_at"))
+
+	var (
+		listening  statistics.Listening
+		avgSeconds float64
+	)
+	stationID := appshared.StationID(ctx)
+	if err := r.pool.QueryRow(ctx, summary, window.From, window.To, stationID).Scan(&listening.Sessions, &avgSeconds, &listening.Hours); err != nil {
+		return nil, err
+	}
+	listening.AverageSession = time.Duration(avgSeconds * float64(time.Second))
+
+	// Each session contributes its overlap with every day it spans
+	daily := fmt.Sprintf(`
+		SELECT day::date,
+			COUNT(*) FILTER (WHERE s.started_at >= day),
+			SUM(EXTRACT(EPOCH FROM
+				LEAST(COALESCE(s.ended_at, s.last_seen_at), day + INTERVAL '1 day') - GREATEST(s.started_at, day)
+			))::float8 / 3600
+		FROM listener_sessions s
+		CROSS JOIN LATERAL generate_series(
+			date_trunc('day', s.started_at), COALESCE(s.ended_at, s.last_seen_at), INTERVAL '1 day'
+		) AS day
+		WHERE s.station_id = $3 AND %s
+		GROUP BY day ORDER BY day
+	`, windowCondition("s.started_at"))
+
+	rows, err := r.pool.Query(ctx, daily, window.From, window.To, stationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	listening.Days = make([]*statistics.ListeningDay, 0)
+	for rows.Next() {
+		var d statistics.ListeningDay
+		if err := rows.Scan(&d.Date, &d.Sessions, &d.Hours); err != nil {
+			return nil, err
+		}
+		listening.Days = append(listening.Days, &d)
+	}
+
+	return &listening, rows.Err()
+}
+
+// artistStatsColumns aggregates the tracks aliased t per artist slug.
+const artistStatsColumns = `
+	t.artist_slug,
+	mode() WITHIN GROUP (ORDER BY t.artist),
+	COALESCE((array_agg(t.cover ORDER BY t.rotate DESC) FILTER (WHERE t.cover != ''))[1], ''),
+	SUM(t.rotate), SUM(t.likes), SUM(t.dislikes), SUM(t.listeners)`
+
+func (r *StatisticsRepository) queryTopReactions(ctx context.Context, reactionType string, page statistics.Page) ([]*statistics.TrackStats, error) {
+	return r.queryTracks(ctx, fmt.Sprintf(`
+		SELECT t.title, t.artist, t.song, t.cover, t.rotate, t.likes, t.dislikes, t.listeners, COUNT(*) AS n
+		FROM reactions x JOIN tracks t ON t.station_id = x.station_id AND t.id = x.track_id
+		WHERE x.station_id = $6 AND x.reaction = $5 AND %s
+		GROUP BY t.station_id, t.id ORDER BY n DESC, t.id LIMIT $3 OFFSET $4
+	`, windowCondition("x.created_at")), page.Window.From, page.Window.To, page.Limit, page.Offset, reactionType, appshared.StationID(ctx))
+}
+
+func (r *StatisticsRepository) queryTracks(ctx context.Context, query string, args ...interface{}) ([]*statistics.TrackStats, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +252,37 @@ func (r *StatisticsRepository) queryTracks(ctx context.Context, query string) ([
 	tracks := make([]*statistics.TrackStats, 0)
 	for rows.Next() {
 		var t statistics.TrackStats
-		if err := rows.Scan(&t.Title, &t.Cover, &t.Rotate, &t.Likes, &t.Dislikes, &t.Listeners); err != nil {
+		if err := rows.Scan(&t.Title, &t.Artist, &t.Song, &t.Cover, &t.Rotate, &t.Likes, &t.Dislikes, &t.Listeners, &t.Count); err != nil {
 			return nil, err
 		}
 		tracks = append(tracks, &t)
 	}
 	return tracks, rows.Err()
 }
+
+func (r *StatisticsRepository) queryArtists(ctx context.Context, query string, args ...interface{}) ([]*statistics.TrackStats, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	artists := make([]*statistics.TrackStats, 0)
+	for rows.Next() {
+		var a statistics.TrackStats
+		if err := rows.Scan(&a.Slug, &a.Artist, &a.Cover, &a.Rotate, &a.Likes, &a.Dislikes, &a.Listeners, &a.Count); err != nil {
+			return nil, err
+		}
+		a.Title = a.Artist
+		artists = append(artists, &a)
+	}
+	return artists, rows.Err()
+}
+
+// windowCondition restricts a timestamp column to the optional window bounds bound as $1 and $2.
+func windowCondition(column string) string {
+	return fmt.Sprintf(
+		"($1::timestamptz IS NULL OR %[1]s >= $1) AND ($2::timestamptz IS NULL OR %[1]s < $2)",
+		column,
+	)
+}
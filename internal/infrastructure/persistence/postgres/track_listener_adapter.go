@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"hub/internal/application/listener"
+	appshared "hub/internal/application/shared"
 )
 
 // TrackListenerAdapter adapts TrackRepositstener.TrackRepository interface.
@@ -20,15 +21,15 @@ var _ listener.TrackRepository = (*TrackListenerAdapter)(nil)
 
 // ExistsByID checks if a track exists by string ID.
 func (a *TrackListenerAdapter) ExistsByID(ctx context.Context, id string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM tracks WHERE id = $1)`
+	query := `SELECT EXISTS(SELECT 1 FROM tracks WHERE station_id = $1 AND id = $2)`
 	var exists bool
-	err := a.repo.pool.QueryRow(ctx, query, id).Scan(&exists)
+	err := a.repo.pool.QueryRow(ctx, query, appshared.StationID(ctx), id).Scan(&exists)
 	return exists, err
 }
 
 // UpdateListenerCount updates listener count by string ID.
 func (a *TrackListenerAdapter) UpdateListenerCount(ctx context.Context, trackID string, count int) error {
-	query := `UPDATE tracks SET listeners = $1, updated_at = NOW() WHERE id = $2`
-	_, err := a.repo.pool.Exec(ctx, query, count, trackID)
+	query := `UPDATE tracks SET listeners = $1, updated_at = NOW() WHERE station_id = $2 AND id = $3`
+	_, err := a.repo.pool.Exec(ctx, query, count, appshared.StationID(ctx), trackID)
 	return err
 }
@@ -3,9 +3,14 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/artist"
 	"hub/internal/domain/track"
+	"hub/internal/infrastructure/metrics"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,36 +18,46 @@ import (
 
 // TrackRepository implements track.Repository using PostgreSQL.
 type TrackRepository struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
 }
 
 // NewTrackRepository creates a new TrackRepository.
-func NewTrackRepository(pool *pgxpool.Pool) *TrackRepository {
-	return &TrackRepository{pool: pool}
+func NewTrackRepository(pool *pgxpool.Pool, m *metrics.Metrics) *TrackRepository {
+	return &TrackRepository{pool: pool, metrics: m}
 }
 
-// Save persists a track aggregate.
+// Save persists a track aggregate in the station the context is scoped to.
+// The reaction and listener counters of an existing track are left alone:
+// reactions and the listener poll maintain them.
 func (r *TrackRepository) Save(ctx context.Context, t *track.Track) error {
+	defer observe(r.metrics, "tracks.save")()
+
 	query := `
-		INSERT INTO tracks (id, title, cover, rotate, likes, dislikes, listeners, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		ON CONFLICT (id) DO UPDATE SET
+		INSERT INTO tracks (id, title, artist, artist_slug, song, featuring, cover, rotate, likes, dislikes, listeners, created_at, updated_at, station_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (station_id, id) DO UPDATE SET
 			title = EXCLUDED.title,
+			artist = EXCLUDED.artist,
+			artist_slug = EXCLUDED.artist_slug,
+			song = EXCLUDED.song,
+			featuring = EXCLUDED.featuring,
 			cover = CASE
 				WHEN (tracks.cover IS NULL OR tracks.cover = '') AND EXCLUDED.cover != ''
 				THEN EXCLUDED.cover
 				ELSE tracks.cover
 			END,
 			rotate = EXCLUDED.rotate,
-			likes = EXCLUDED.likes,
-			dislikes = EXCLUDED.dislikes,
-			listeners = EXCLUDED.listeners,
 			updated_at = EXCLUDED.updated_at
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query,
 		t.ID().String(),
 		t.Title().String(),
+		t.Name().Artist(),
+		artist.NewSlug(t.Name().Artist()).String(),
+		t.Name().Song(),
+		t.Name().Featuring(),
 		t.Cover().String(),
 		t.Rotate(),
 		t.Likes(),
@@ -50,6 +65,7 @@ func (r *TrackRepository) Save(ctx context.Context, t *track.Track) error {
 		t.Listeners(),
 		t.CreatedAt(),
 		time.Now(),
+		appshared.StationID(ctx),
 	)
 
 	return err
@@ -57,21 +73,35 @@ func (r *TrackRepository) Save(ctx context.Context, t *track.Track) error {
 
 // FindByID retrieves a track by its ID.
 func (r *TrackRepository) FindByID(ctx context.Context, id track.TrackID) (*track.Track, error) {
+	defer observe(r.metrics, "tracks.find_by_id")()
+	return r.findByID(ctx, id, "")
+}
+
+// FindByIDForUpdate retrieves a track by its ID and locks its row until the
+// transaction carried by ctx ends.
+func (r *TrackRepository) FindByIDForUpdate(ctx context.Context, id track.TrackID) (*track.Track, error) {
+	defer observe(r.metrics, "tracks.find_by_id_for_update")()
+	return r.findByID(ctx, id, "FOR UPDATE")
+}
+
+func (r *TrackRepository) findByID(ctx context.Context, id track.TrackID, lock string) (*track.Track, error) {
 	query := `
-		SELECT id, title, cover, rotate, likes, dislikes, listeners, created_at, updated_at
-		FROM tracks WHERE id = $1
-	`
+		SELECT id, title, artist, song, featuring, cover, rotate, likes, dislikes, listeners, created_at, updated_at
+		FROM tracks WHERE station_id = $1 AND id = $2
+	` + lock
 
-	row := r.pool.QueryRow(ctx, query, id.String())
+	row := GetTxOrPool(ctx, r.pool).QueryRow(ctx, query, appshared.StationID(ctx), id.String())
 	return r.scanTrack(row)
 }
 
 // Exists checks if a track with the given ID exists.
 func (r *TrackRepository) Exists(ctx context.Context, id track.TrackID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM tracks WHERE id = $1)`
+	defer observe(r.metrics, "tracks.exists")()
+
+	query := `SELECT EXISTS(SELECT 1 FROM tracks WHERE station_id = $1 AND id = $2)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, id.String()).Scan(&exists)
+	err := GetTxOrPool(ctx, r.pool).QueryRow(ctx, query, appshared.StationID(ctx), id.String()).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -81,20 +111,120 @@ func (r *TrackRepository) Exists(ctx context.Context, id track.TrackID) (bool, e
 
 // UpdateListenerCount updates the listener count for a track.
 func (r *TrackRepository) UpdateListenerCount(ctx context.Context, id track.TrackID, count int) error {
-	query := `UPDATE tracks SET listeners = $1, updated_at = NOW() WHERE id = $2`
-	_, err := r.pool.Exec(ctx, query, count, id.String())
+	defer observe(r.metrics, "tracks.update_listener_count")()
+
+	query := `UPDATE tracks SET listeners = $1, updated_at = NOW() WHERE station_id = $2 AND id = $3`
+	_, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query, count, appshared.StationID(ctx), id.String())
 	return err
 }
 
+// UpdateName updates the parsed artist and song of a track.
+func (r *TrackRepository) UpdateName(ctx context.Context, id track.TrackID, name track.Name) error {
+	defer observe(r.metrics, "tracks.update_name")()
+
+	query := `UPDATE tracks SET artist = $1, artist_slug = $2, song = $3, featuring = $4 WHERE station_id = $5 AND id = $6`
+	_, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query, name.Artist(), artist.NewSlug(name.Artist()).String(), name.Song(), name.Featuring(), appshared.StationID(ctx), id.String())
+	return err
+}
+
+// RefreshArtistSlug rewrites the artist slug of a track if it differs from
+// the one artist.NewSlug derives.
+func (r *TrackRepository) RefreshArtistSlug(ctx context.Context, id track.TrackID, name track.Name) (bool, error) {
+	defer observe(r.metrics, "tracks.refresh_artist_slug")()
+
+	query := `UPDATE tracks SET artist_slug = $1 WHERE station_id = $2 AND id = $3 AND artist_slug != $1`
+	tag, err := GetTxOrPool(ctx, r.pool).Exec(ctx, query, artist.NewSlug(name.Artist()).String(), appshared.StationID(ctx), id.String())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// trackSortColumns maps sort fields to their column and SQL type.
+var trackSortColumns = map[track.SortField][2]string{
+	track.SortByRotate:    {"rotate", "integer"},
+	track.SortByLikes:     {"likes", "integer"},
+	track.SortByDislikes:  {"dislikes", "integer"},
+	track.SortByListeners: {"listeners", "integer"},
+	track.SortByCreatedAt: {"created_at", "timestamptz"},
+	track.SortByUpdatedAt: {"updated_at", "timestamptz"},
+}
+
+// likeEscaper escapes LIKE wildcards in user-provided search terms.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// FindByCriteria retrieves tracks matching the criteria using keyset pagination.
+// Title search is served by the trigram index on tracks.title.
+func (r *TrackRepository) FindByCriteria(ctx context.Context, criteria track.Criteria) ([]*track.Track, error) {
+	defer observe(r.metrics, "tracks.find_by_criteria")()
+
+	sort, ok := trackSortColumns[criteria.SortBy]
+	if !ok {
+		return nil, track.ErrInvalidSortField
+	}
+	column, columnType := sort[0], sort[1]
+
+	direction, comparison := "ASC", ">"
+	if criteria.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	conditions := []string{"station_id = $1"}
+	args := []interface{}{appshared.StationID(ctx)}
+
+	if criteria.Search != "" {
+		args = append(args, "%"+likeEscaper.Replace(criteria.Search)+"%")
+		conditions = append(conditions, fmt.Sprintf("title ILIKE $%d", len(args)))
+	}
+	if criteria.Artist != "" {
+		args = append(args, criteria.Artist)
+		conditions = append(conditions, fmt.Sprintf("artist_slug = $%d", len(args)))
+	}
+	if criteria.After != nil {
+		args = append(args, criteria.After.Value, criteria.After.ID.String())
+		conditions = append(conditions, fmt.Sprintf(
+			"(%s, id) %s ($%d::%s, $%d)", column, comparison, len(args)-1, columnType, len(args),
+		))
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+
+	args = append(args, criteria.Limit)
+	query := fmt.Sprintf(`
+		SELECT id, title, artist, song, featuring, cover, rotate, likes, dislikes, listeners, created_at, updated_at
+		FROM tracks
+		%s
+		ORDER BY %s %s, id %s
+		LIMIT $%d
+	`, where, column, direction, direction, len(args))
+
+	rows, err := GetTxOrPool(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tracks := make([]*track.Track, 0)
+	for rows.Next() {
+		t, err := r.scanTrack(rows)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, t)
+	}
+	return tracks, rows.Err()
+}
+
 // scanTrack scans a row into a Track aggregate.
 func (r *TrackRepository) scanTrack(row pgx.Row) (*track.Track, error) {
 	var (
-		id, title, cover                   string
+		id, title, artist, song, cover     string
+		featuring                          []string
 		rotate, likes, dislikes, listeners int
 		createdAt, updatedAt               time.Time
 	)
 
-	err := row.Scan(&id, &title, &cover, &rotate, &likes, &dislikes, &listeners, &createdAt, &updatedAt)
+	err := row.Scan(&id, &title, &artist, &song, &featuring, &cover, &rotate, &likes, &dislikes, &listeners, &createdAt, &updatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, track.ErrTrackNotFound
@@ -102,5 +232,5 @@ func (r *TrackRepository) scanTrack(row pgx.Row) (*track.Track, error) {
 		return nil, err
 	}
 
-	return track.ReconstructTrack(id, title, cover, rotate, likes, dislikes, listeners, createdAt, updatedAt)
+	return track.ReconstructTrack(id, title, artist, song, featuring, cover, rotate, likes, dislikes, listeners, createdAt, updatedAt)
 }
@@ -75,6 +75,15 @@ func GetTxOrPool(ctx context.Context, pool *pgxpool.Pool) Querier {
 	return pool
 }
 
+// BeginTx starts a transaction, or a savepoint when the context already
+// carries one, so a repository stays atomic both on its own and inside a unit of work.
+func BeginTx(ctx context.Context, pool *pgxpool.Pool) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(shared.TxKey()).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return pool.Begin(ctx)
+}
+
 // Querier is an interface for database operations.
 type Querier interface {
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/domain/webhook"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// deliveryColumns is the column list scanned by scanDelivery.
+const deliveryColumns = `id, webhook_id::text, COALESCE(event_id, ''), event_name, station, payload, status, attempts, response_status, last_error, next_attempt_at, created_at, delivered_at`
+
+// WebhookDeliveryRepository implements webhook.DeliveryRepository using PostgreSQL.
+type WebhookDeliveryRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewWebhookDeliveryRepository creates a new WebhookDeliveryRepository.
+func NewWebhookDeliveryRepository(pool *pgxpool.Pool, m *metrics.Metrics) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{pool: pool, metrics: m}
+}
+
+var _ webhook.DeliveryRepository = (*WebhookDeliveryRepository)(nil)
+
+// Enqueue stores pending deliveries in one batch. A delivery of an event
+// already enqueued for the webhook is skipped.
+func (r *WebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []*webhook.Delivery) error {
+	defer observe(r.metrics, "webhook_deliveries.enqueue")()
+
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_name, station, payload, next_attempt_at, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, d := range deliveries {
+		batch.Queue(query, d.WebhookID(), d.EventID(), d.EventName(), d.Station(), d.Payload(), d.NextAttemptAt(), d.CreatedAt())
+	}
+
+	return r.pool.SendBatch(ctx, batch).Close()
+}
+
+// FindByWebhook retrieves a webhook's deliveries, newest first.
+// An ID that isn't a UUID matches no webhook.
+func (r *WebhookDeliveryRepository) FindByWebhook(ctx context.Context, webhookID string, criteria webhook.DeliveryCriteria) ([]*webhook.Delivery, error) {
+	defer observe(r.metrics, "webhook_deliveries.find_by_webhook")()
+
+	if _, err := uuid.Parse(webhookID); err != nil {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1::uuid AND ($2::text IS NULL OR status = $2)
+		ORDER BY id DESC
+		LIMIT $3
+	`
+
+	var status *string
+	if criteria.Status != nil {
+		s := criteria.Status.String()
+		status = &s
+	}
+
+	return r.queryDeliveries(ctx, query, webhookID, status, criteria.Limit)
+}
+
+// Claim leases due pending deliveries. SKIP LOCKED lets several workers claim concurrently.
+func (r *WebhookDeliveryRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	defer observe(r.metrics, "webhook_deliveries.claim")()
+
+	query := `
+		UPDATE webhook_deliveries SET next_attempt_at = NOW() + $2::bigint * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+
+	return r.queryDeliveries(ctx, query, limit, lease.Milliseconds())
+}
+
+// MarkSucceeded records a successful attempt.
+func (r *WebhookDeliveryRepository) MarkSucceeded(ctx context.Context, id int64, responseStatus int) error {
+	defer observe(r.metrics, "webhook_deliveries.mark_succeeded")()
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'succeeded', attempts = attempts + 1, response_status = $2, last_error = NULL, delivered_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id, responseStatus)
+	return err
+}
+
+// MarkFailed records a failed attempt and schedules the retry or dead-letters the delivery.
+func (r *WebhookDeliveryRepository) MarkFailed(ctx context.Context, id int64, responseStatus int, cause string, nextAttempt time.Time, dead bool) error {
+	defer observe(r.metrics, "webhook_deliveries.mark_failed")()
+
+	status := webhook.StatusPending
+	if dead {
+		status = webhook.StatusDead
+	}
+
+	var response *int
+	if responseStatus != 0 {
+		response = &responseStatus
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, response_status = $3, last_error = $4, next_attempt_at = $5
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id, status.String(), response, cause, nextAttempt)
+	return err
+}
+
+func (r *WebhookDeliveryRepository) queryDeliveries(ctx context.Context, query string, args ...interface{}) ([]*webhook.Delivery, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*webhook.Delivery, 0)
+	for rows.Next() {
+		d, err := r.scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// scanDelivery scans a row into a Delivery.
+func (r *WebhookDeliveryRepository) scanDelivery(row pgx.Row) (*webhook.Delivery, error) {
+	var (
+		id                                     int64
+		webhookID, eventID, eventName, station string
+		payload                                []byte
+		status                                 string
+		attempts                               int
+		responseStatus                         *int
+		lastError                              *string
+		nextAttemptAt, createdAt               time.Time
+		deliveredAt                            *time.Time
+	)
+
+	err := row.Scan(&id, &webhookID, &eventID, &eventName, &station, &payload, &status, &attempts,
+		&responseStatus, &lastError, &nextAttemptAt, &createdAt, &deliveredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var errText string
+	if lastError != nil {
+		errText = *lastError
+	}
+
+	return webhook.ReconstructDelivery(
+		id, webhookID, eventID, eventName, station, payload,
+		webhook.DeliveryStatus(status), attempts, responseStatus, errText,
+		nextAttemptAt, createdAt, deliveredAt,
+	), nil
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/webhook"
+	"hub/internal/infrastructure/metrics"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// webhookColumns is the column list scanned by scanWebhook.
+const webhookColumns = `id, url, secret, events, COALESCE(station_id, 0), created_at`
+
+// WebhookRepository implements webhook.Repository using PostgreSQL.
+type WebhookRepository struct {
+	pool    *pgxpool.Pool
+	metrics *metrics.Metrics
+}
+
+// NewWebhookRepository creates a new WebhookRepository.
+func NewWebhookRepository(pool *pgxpool.Pool, m *metrics.Metrics) *WebhookRepository {
+	return &WebhookRepository{pool: pool, metrics: m}
+}
+
+var _ webhook.Repository = (*WebhookRepository)(nil)
+
+// Save persists a webhook.
+func (r *WebhookRepository) Save(ctx context.Context, w *webhook.Webhook) error {
+	defer observe(r.metrics, "webhooks.save")()
+
+	query := `
+		INSERT INTO webhooks (id, url, secret, events, station_id, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6)
+		ON CONFLICT (id) DO UPDATE SET
+			url = EXCLUDED.url,
+			events = EXCLUDED.events
+	`
+
+	_, err := r.pool.Exec(ctx, query, w.ID(), w.URL(), w.Secret(), w.Events(), w.StationID(), w.CreatedAt())
+	return err
+}
+
+// FindByID retrieves a webhook by its ID. An ID that isn't a UUID matches no webhook.
+func (r *WebhookRepository) FindByID(ctx context.Context, id string) (*webhook.Webhook, error) {
+	defer observe(r.metrics, "webhooks.find_by_id")()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1::uuid`
+
+	return r.scanWebhook(r.pool.QueryRow(ctx, query, id))
+}
+
+// FindAll retrieves every webhook, newest first.
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*webhook.Webhook, error) {
+	defer observe(r.metrics, "webhooks.find_all")()
+
+	query := `SELECT ` + webhookColumns + ` FROM webhooks ORDER BY created_at DESC`
+
+	return r.queryWebhooks(ctx, query)
+}
+
+// FindByEvent retrieves the webhooks subscribed to an event, directly or
+// through "*", of the context's station or of every station.
+func (r *WebhookRepository) FindByEvent(ctx context.Context, eventName string) ([]*webhook.Webhook, error) {
+	defer observe(r.metrics, "webhooks.find_by_event")()
+
+	query := `
+		SELECT ` + webhookColumns + ` FROM webhooks
+		WHERE ($1 = ANY(events) OR $2 = ANY(events))
+			AND (station_id IS NULL OR station_id = $3)
+		ORDER BY created_at
+	`
+
+	return r.queryWebhooks(ctx, query, eventName, webhook.AllEvents, appshared.StationID(ctx))
+}
+
+// Delete removes a webhook; its deliveries are removed by the foreign key.
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	defer observe(r.metrics, "webhooks.delete")()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return webhook.ErrWebhookNotFound
+	}
+
+	result, err := r.pool.Exec(ctx, `DELETE FROM webhooks WHERE id = $1::uuid`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepository) queryWebhooks(ctx context.Context, query string, args ...interface{}) ([]*webhook.Webhook, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]*webhook.Webhook, 0)
+	for rows.Next() {
+		w, err := r.scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+	return webhooks, rows.Err()
+}
+
+// scanWebhook scans a row into a Webhook entity.
+func (r *WebhookRepository) scanWebhook(row pgx.Row) (*webhook.Webhook, error) {
+	var (
+		id, url, secret string
+		events          []string
+		stationID       int64
+		createdAt       time.Time
+	)
+
+	if err := row.Scan(&id, &url, &secret, &events, &stationID, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return webhook.ReconstructWebhook(id, url, secret, events, stationID, createdAt), nil
+}
@@ -0,0 +1,135 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// TTL is how long a replica's counts outlive its last sync, so the
+	// connections of a replica that died stop counting.
+	TTL = 30 * time.Second
+
+	keyPrefix   = "hub:presence:"
+	replicasKey = "hub:presence:replicas"
+	channel     = "hub:presence"
+)
+
+// Tracker counts live connections per station across replicas in Redis.
+// Each replica keeps its own counts in a hash that expires unless synced,
+// and announces changes on a Pub/Sub channel every replica watches.
+type Tracker struct {
+	client  *redis.Client
+	replica string
+}
+
+// NewTracker creates a new Tracker for this replica.
+func NewTracker(client *redis.Client) *Tracker {
+	host, _ := os.Hostname()
+	return &Tracker{
+		client:  client,
+		replica: fmt.Sprintf("%s-%d", host, os.Getpid()),
+	}
+}
+
+// Add changes this replica's connection count of a station by delta and
+// announces the change.
+func (t *Tracker) Add(ctx context.Context, stationID int64, delta int) error {
+	station := strconv.FormatInt(stationID, 10)
+
+	pipe := t.client.TxPipeline()
+	pipe.HIncrBy(ctx, t.key(), station, int64(delta))
+	t.keepAlive(ctx, pipe)
+	pipe.Publish(ctx, channel, station)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Sync replaces this replica's connection counts, keeping them alive, and
+// announces them so other replicas pick up counts that expired meanwhile.
+func (t *Tracker) Sync(ctx context.Context, counts map[int64]int) error {
+	pipe := t.client.TxPipeline()
+	pipe.Del(ctx, t.key())
+	for stationID, count := range counts {
+		pipe.HSet(ctx, t.key(), strconv.FormatInt(stationID, 10), count)
+	}
+	t.keepAlive(ctx, pipe)
+	for stationID := range counts {
+		pipe.Publish(ctx, channel, strconv.FormatInt(stationID, 10))
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Total returns the connection count of a station across live replicas.
+func (t *Tracker) Total(ctx context.Context, stationID int64) (int, error) {
+	cutoff := strconv.FormatInt(time.Now().Add(-TTL).Unix(), 10)
+	if err := t.client.ZRemRangeByScore(ctx, replicasKey, "-inf", "("+cutoff).Err(); err != nil {
+		return 0, err
+	}
+
+	replicas, err := t.client.ZRange(ctx, replicasKey, 0, -1).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	station := strconv.FormatInt(stationID, 10)
+	pipe := t.client.Pipeline()
+	counts := make([]*redis.StringCmd, len(replicas))
+	for i, replica := range replicas {
+		counts[i] = pipe.HGet(ctx, keyPrefix+replica, station)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+
+	total := 0
+	for _, cmd := range counts {
+		if n, err := cmd.Int(); err == nil && n > 0 {
+			total += n
+		}
+	}
+	return total, nil
+}
+
+// Watch calls fn with the station of every announced change until ctx ends.
+func (t *Tracker) Watch(ctx context.Context, fn func(stationID int64)) error {
+	sub := t.client.Subscribe(ctx, channel)
+	defer sub.Close()
+
+	// Wait for the subscription so no announcement after Watch starts is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if stationID, err := strconv.ParseInt(msg.Payload, 10, 64); err == nil {
+				fn(stationID)
+			}
+		}
+	}
+}
+
+// keepAlive queues the expiry and liveness refresh of this replica's counts.
+func (t *Tracker) keepAlive(ctx context.Context, pipe redis.Pipeliner) {
+	pipe.Expire(ctx, t.key(), TTL)
+	pipe.ZAdd(ctx, replicasKey, redis.Z{Score: float64(time.Now().Unix()), Member: t.replica})
+}
+
+func (t *Tracker) key() string {
+	return keyPrefix + t.replica
+}
@@ -0,0 +1,19 @@
+package retry
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns the delay before the given attempt (1-based): exponential
+// from base, capped at max, with jitter so retries of a failed batch spread out.
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	d := max
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt < 32 && base<<(attempt-1) < max {
+		d = base << (attempt - 1)
+	}
+	return d/2 + rand.N(d/2+1)
+}
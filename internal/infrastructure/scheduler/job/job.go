@@ -1,10 +1,111 @@
 package job
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Overlap policies decide what happens when a job is due while its previous
+// run is still in progress.
+const (
+	// OverlapSkip drops the new run.
+	OverlapSkip = "skip"
+	// OverlapDelay starts the new run once the previous one finishes.
+	OverlapDelay = "delay"
+	// OverlapAllow runs both concurrently.
+	OverlapAllow = "allow"
+)
+
+// Triggers record why a job ran.
+const (
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobRunning  = errors.New("job already running")
+)
+
 type (
+	// Job is a named unit of scheduled work.
 	Job interface {
-		Run()
+		Name() string
+		Run(ctx context.Context) error
+	}
+
+	// Options configure how and when a job runs.
+	Options struct {
+		Spec    string
+		Enabled bool
+		Overlap string
+		Timeout time.Duration
+	}
+
+	// Run is a recorded job execution.
+	Run struct {
+		ID         int64
+		Job        string
+		Trigger    string
+		StartedAt  time.Time
+		FinishedAt *time.Time
+		Error      string
+	}
+
+	// Status describes a registered job.
+	Status struct {
+		Name    string
+		Options Options
+		Running bool
+		NextRun *time.Time
+		LastRun *Run
 	}
-	job struct {
-		Spec string
+
+	// RunStore persists job run history.
+	RunStore interface {
+		// Start records the start of a run and returns its ID.
+		Start(ctx context.Context, job, trigger string, startedAt time.Time) (int64, error)
+
+		// Finish records the end of a run and its error, if any.
+		Finish(ctx context.Context, id int64, finishedAt time.Time, cause error) error
+
+		// Latest returns the most recent run of the given jobs, keyed by job name.
+		Latest(ctx context.Context, jobs []string) (map[string]Run, error)
+
+		// Prune deletes runs started before the given time.
+		Prune(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	funcJob struct {
+		name string
+		fn   func(ctx context.Context) error
 	}
 )
+
+// New creates a job running fn.
+func New(name string, fn func(ctx context.Context) error) Job {
+	return &funcJob{name: name, fn: fn}
+}
+
+func (j *funcJob) Name() string {
+	return j.name
+}
+
+func (j *funcJob) Run(ctx context.Context) error {
+	return j.fn(ctx)
+}
+
+// Validate checks the overlap policy and timeout.
+func (o Options) Validate() error {
+	switch o.Overlap {
+	case OverlapSkip, OverlapDelay, OverlapAllow:
+	default:
+		return fmt.Errorf("unknown overlap policy %q", o.Overlap)
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("negative timeout %s", o.Timeout)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/logger"
+)
+
+// PruneJobRuns is the name of the job deleting old run history.
+const PruneJobRuns = "prune_job_runs"
+
+// runRetention is how long job run history is kept.
+const runRetention = 7 * 24 * time.Hour
+
+// NewPruneJobRuns creates the job that keeps the run history bounded;
+// frequent jobs would otherwise grow it by thousands of rows a day.
+func NewPruneJobRuns(store RunStore, log *logger.Logger) Job {
+	return New(PruneJobRuns, func(ctx context.Context) error {
+		n, err := store.Prune(ctx, time.Now().Add(-runRetention))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.WithContext("job", PruneJobRuns).Infof("Pruned %d job runs", n)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,30 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"hub/internal/infrastructure/outbox"
+	"hub/internal/logger"
+)
+
+// PruneOutbox is the name of the job deleting delivered outbox events.
+const PruneOutbox = "prune_outbox"
+
+// outboxRetention is how long delivered outbox events are kept.
+const outboxRetention = 24 * time.Hour
+
+// NewPruneOutbox creates the job that keeps the outbox bounded; every
+// track change and reaction adds a row that is useless once delivered.
+func NewPruneOutbox(store outbox.Store, log *logger.Logger) Job {
+	return New(PruneOutbox, func(ctx context.Context) error {
+		n, err := store.Prune(ctx, time.Now().Add(-outboxRetention))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.WithContext("job", PruneOutbox).Infof("Pruned %d outbox events", n)
+		}
+		return nil
+	})
+}
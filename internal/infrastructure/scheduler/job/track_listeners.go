@@ -0,0 +1,63 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"hub/internal/application/listener"
+	appshared "hub/internal/application/shared"
+	appstation "hub/internal/application/station"
+	domainlistener "hub/internal/domain/listener"
+	"hub/internal/domain/station"
+	"hub/internal/infrastructure/metrics"
+)
+
+// TrackListeners is the name of the job polling the streaming servers for
+// listeners.
+const TrackListeners = "track_listeners"
+
+// NewTrackListeners creates the job that records current listeners of every
+// station and pushes their count to metrics and, through the broadcast
+// publisher, to the now-playing subscribers of every replica.
+// Stations are polled concurrently, so a hung server uses up the run's
+// timeout for its own station only.
+func NewTrackListeners(ls listener.Service, pub appshared.BroadcastPublisher, stations *appstation.Registry, m *metrics.Metrics) Job {
+	return New(TrackListeners, func(ctx context.Context) error {
+		all := stations.All()
+		errs := make([]error, len(all))
+
+		var wg sync.WaitGroup
+		for i, s := range all {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = trackStation(ctx, ls, pub, m, s)
+			}()
+		}
+		wg.Wait()
+
+		return errors.Join(errs...)
+	})
+}
+
+// trackStation polls the listeners of a station and publishes their count.
+func trackStation(ctx context.Context, ls listener.Service, pub appshared.BroadcastPublisher, m *metrics.Metrics, s *station.Station) error {
+	ctx = appshared.WithStationID(ctx, s.ID())
+
+	active, err := ls.TrackCurrentListeners(ctx)
+	if err != nil {
+		err = fmt.Errorf("station %s: %w", s.Slug(), err)
+	}
+	// A failed poll is no audience drop: keep the last count
+	if errors.Is(err, listener.ErrCountUnavailable) {
+		return err
+	}
+
+	m.SetActiveListeners(s.Slug(), active)
+	if perr := pub.Publish(ctx, domainlistener.NewListenersCounted(active)); perr != nil {
+		err = errors.Join(err, fmt.Errorf("station %s: %w", s.Slug(), perr))
+	}
+	return err
+}
@@ -2,9 +2,14 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"sync/atomic"
+	"time"
 
-	"hub/internal/application/listener"
+	"hub/internal/infrastructure/leader"
+	"hub/internal/infrastructure/metrics"
+	"hub/internal/infrastructure/scheduler/job"
 	"hub/internal/logger"
 
 	"github.com/robfig/cron/v3"
@@ -12,25 +17,92 @@ import (
 
 type (
 	Scheduler interface {
+		// Register adds a job. Jobs must be registered before Start.
+		Register(j job.Job, opts job.Options) error
 		Start()
 		Stop(ctx context.Context) error
+
+		// Jobs describes the registered jobs in registration order. A job
+		// holding its cluster-wide lock is running, on any replica.
+		Jobs(ctx context.Context) ([]job.Status, error)
+
+		// Trigger runs a job now, whether or not it is enabled or this
+		// replica is the leader. Its overlap policy still applies across
+		// replicas: a job that skips overlapping runs returns
+		// job.ErrJobRunning if it is running on any replica.
+		Trigger(ctx context.Context, name string) error
 	}
 
 	scheduler struct {
-		cron            *cron.Cron
-		listenerService listener.Service
-		logger          *logger.Logger
-		isRunning       atomic.Bool
-		isStarted       atomic.Bool
+		cron    *cron.Cron
+		leader  *leader.Elector
+		locks   *leader.Locks
+		store   job.RunStore
+		metrics *metrics.Metrics
+		logger  *logger.Logger
+
+		entries []*entry
+		byName  map[string]*entry
+		manual  sync.WaitGroup
+
+		isStarted atomic.Bool
+	}
+
+	entry struct {
+		job     job.Job
+		opts    job.Options
+		cronID  cron.EntryID
+		running atomic.Int32
+		busy    atomic.Bool
+		mu      sync.Mutex
 	}
 )
 
-func NewScheduler(listenerService listener.Service, log *logger.Logger) Scheduler {
+// NewScheduler creates a scheduler whose jobs only run on schedule while this
+// replica holds the leadership, so enabling it on every replica is safe.
+// Runs of jobs that don't allow overlaps also hold a cluster-wide lock, so
+// manual runs and leadership changes can't overlap them either.
+func NewScheduler(le *leader.Elector, locks *leader.Locks, store job.RunStore, m *metrics.Metrics, log *logger.Logger) Scheduler {
 	return &scheduler{
-		cron:            cron.New(cron.WithSeconds()),
-		listenerService: listenerService,
-		logger:          log,
+		cron:    cron.New(cron.WithSeconds()),
+		leader:  le,
+		locks:   locks,
+		store:   store,
+		metrics: m,
+		logger:  log,
+		byName:  make(map[string]*entry),
+	}
+}
+
+func (s *scheduler) Register(j job.Job, opts job.Options) error {
+	if _, ok := s.byName[j.Name()]; ok {
+		return fmt.Errorf("job %s already registered", j.Name())
 	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("job %s: %w", j.Name(), err)
+	}
+
+	e := &entry{job: j, opts: opts}
+	if opts.Enabled {
+		id, err := s.cron.AddFunc(opts.Spec, func() {
+			if !s.leader.IsLeader() {
+				return
+			}
+			if !e.claim() {
+				s.logger.WithContext("scheduler", e.job.Name()).Debug("Skipping job - previous run still in progress")
+				return
+			}
+			s.execute(e, job.TriggerSchedule, nil)
+		})
+		if err != nil {
+			return fmt.Errorf("job %s: %w", j.Name(), err)
+		}
+		e.cronID = id
+	}
+
+	s.entries = append(s.entries, e)
+	s.byName[j.Name()] = e
+	return nil
 }
 
 func (s *scheduler) Start() {
@@ -39,27 +111,17 @@ func (s *scheduler) Start() {
 		return
 	}
 
-	_, err := s.cron.AddFunc("*/3 * * * * *", func() {
-		if !s.isRunning.CompareAndSwap(false, true) {
-			s.logger.Debug("Skipping listener tracking - previous job still running")
-			return
-		}
-		defer s.isRunning.Store(false)
-
-		ctx := context.Background()
-		if err := s.listenerService.TrackCurrentListeners(ctx); err != nil {
-			s.logger.Errorf("Failed to track listeners: %v", err)
+	for _, e := range s.entries {
+		if e.opts.Enabled {
+			s.logger.Infof("Job %s scheduled - %s", e.job.Name(), e.opts.Spec)
+		} else {
+			s.logger.Infof("Job %s disabled", e.job.Name())
 		}
-	})
-
-	if err != nil {
-		s.logger.Errorf("Failed to add cron job: %v", err)
-		s.isStarted.Store(false)
-		return
 	}
 
+	s.leader.Start()
 	s.cron.Start()
-	s.logger.Info("Scheduler started - tracking listeners every 3 seconds")
+	s.logger.Info("Scheduler started")
 }
 
 func (s *scheduler) Stop(ctx context.Context) error {
@@ -71,14 +133,190 @@ func (s *scheduler) Stop(ctx context.Context) error {
 	s.logger.Info("Stopping scheduler...")
 
 	stopCtx := s.cron.Stop()
+	done := make(chan struct{})
+	go func() {
+		<-stopCtx.Done()
+		s.manual.Wait()
+		close(done)
+	}()
 
 	select {
-	case <-stopCtx.Done():
-		s.isStarted.Store(false)
-		s.logger.Info("Scheduler stopped gracefully")
-		return nil
+	case <-done:
 	case <-ctx.Done():
 		s.logger.Warn("Scheduler stop timed out")
 		return ctx.Err()
 	}
+
+	// Resign only once the running jobs are done, so the next leader
+	// doesn't overlap with them
+	if err := s.leader.Stop(ctx); err != nil {
+		return err
+	}
+
+	s.isStarted.Store(false)
+	s.logger.Info("Scheduler stopped gracefully")
+	return nil
+}
+
+func (s *scheduler) Jobs(ctx context.Context) ([]job.Status, error) {
+	names := make([]string, len(s.entries))
+	for i, e := range s.entries {
+		names[i] = e.job.Name()
+	}
+
+	latest, err := s.store.Latest(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]job.Status, len(s.entries))
+	for i, e := range s.entries {
+		running := e.running.Load() > 0
+		if !running && e.opts.Overlap != job.OverlapAllow {
+			// Runs on other replicas hold the job lock
+			running, err = s.locks.Held(ctx, lockName(e.job.Name()))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		status := job.Status{
+			Name:    e.job.Name(),
+			Options: e.opts,
+			Running: running,
+		}
+		if e.cronID != 0 {
+			if next := s.cron.Entry(e.cronID).Next; !next.IsZero() {
+				status.NextRun = &next
+			}
+		}
+		if run, ok := latest[e.job.Name()]; ok {
+			status.LastRun = &run
+		}
+		statuses[i] = status
+	}
+	return statuses, nil
+}
+
+func (s *scheduler) Trigger(ctx context.Context, name string) error {
+	e, ok := s.byName[name]
+	if !ok {
+		return job.ErrJobNotFound
+	}
+	if !e.claim() {
+		return job.ErrJobRunning
+	}
+
+	// Take the cluster lock before accepting the run, so a run in
+	// progress on another replica is reported instead of skipped silently
+	var release func()
+	if e.opts.Overlap == job.OverlapSkip {
+		var (
+			acquired bool
+			err      error
+		)
+		release, acquired, err = s.locks.TryAcquire(ctx, lockName(name))
+		if err != nil || !acquired {
+			e.busy.Store(false)
+			if err != nil {
+				return fmt.Errorf("failed to take job lock: %w", err)
+			}
+			return job.ErrJobRunning
+		}
+	}
+
+	s.manual.Add(1)
+	go func() {
+		defer s.manual.Done()
+		s.execute(e, job.TriggerManual, release)
+	}()
+	return nil
+}
+
+// lockName returns the name of a job's cluster-wide lock.
+func lockName(job string) string {
+	return "hub:job:" + job
+}
+
+// claim marks a job that skips overlapping runs as running on this
+// replica, and reports whether it was idle. Other policies always claim.
+func (e *entry) claim() bool {
+	if e.opts.Overlap != job.OverlapSkip {
+		return true
+	}
+	return e.busy.CompareAndSwap(false, true)
+}
+
+// execute runs a claimed job under its overlap policy and timeout,
+// recording the run. release is the job lock's if the caller already took it.
+func (s *scheduler) execute(e *entry, trigger string, release func()) {
+	name := e.job.Name()
+	log := s.logger.WithContext("scheduler", name).WithField("trigger", trigger)
+
+	switch e.opts.Overlap {
+	case job.OverlapSkip:
+		defer e.busy.Store(false)
+	case job.OverlapDelay:
+		e.mu.Lock()
+		defer e.mu.Unlock()
+	}
+
+	ctx := context.Background()
+	if e.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.opts.Timeout)
+		defer cancel()
+	}
+
+	// Other replicas may run the job too: after a leadership change, or
+	// when triggered manually
+	switch e.opts.Overlap {
+	case job.OverlapSkip:
+		if release == nil {
+			var (
+				acquired bool
+				err      error
+			)
+			release, acquired, err = s.locks.TryAcquire(ctx, lockName(name))
+			if err != nil {
+				log.WithError(err).Error("failed to take job lock")
+				return
+			}
+			if !acquired {
+				log.Info("Skipping job - running on another replica")
+				return
+			}
+		}
+		defer release()
+	case job.OverlapDelay:
+		release, err := s.locks.Acquire(ctx, lockName(name))
+		if err != nil {
+			log.WithError(err).Error("failed to take job lock")
+			return
+		}
+		defer release()
+	}
+
+	e.running.Add(1)
+	defer e.running.Add(-1)
+
+	start := time.Now()
+	id, err := s.store.Start(ctx, name, trigger, start)
+	if err != nil {
+		log.WithError(err).Error("failed to record job start")
+	}
+
+	err = e.job.Run(ctx)
+	finished := time.Now()
+	s.metrics.RecordJobRun(name, err == nil, finished.Sub(start))
+	if err != nil {
+		log.WithError(err).Error("job failed")
+	}
+
+	if id != 0 {
+		// The job's context may have timed out; recording must not
+		if err := s.store.Finish(context.Background(), id, finished, err); err != nil {
+			log.WithError(err).Error("failed to record job end")
+		}
+	}
 }
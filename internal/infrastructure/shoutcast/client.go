@@ -0,0 +1,86 @@
+// Package shoutcast reads a SHOUTcast DNAS v2 server into the streaming
+// client shapes, so the radio and listener services work unchanged.
+package shoutcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hub/internal/infrastructure/breaker"
+	"hub/internal/infrastructure/streaming"
+	"strings"
+)
+
+// client reads the stream stats and listeners of a SHOUTcast v2 server.
+// Its mounts are stream IDs ("/1", "/2"); listing listeners needs the
+// admin password.
+type client struct {
+	host     string
+	user     string
+	password string
+	mounts   []string
+	http     *streaming.HTTPClient
+}
+
+func NewClient(host, user, password string, mounts []string, http *streaming.HTTPClient) (streaming.Client, error) {
+	if len(mounts) == 0 {
+		return nil, errors.New("no SHOUTcast stream configured")
+	}
+	for _, mount := range mounts {
+		if sid(mount) == "" {
+			return nil, fmt.Errorf("invalid SHOUTcast stream ID %q", mount)
+		}
+	}
+
+	return &client{
+		host:     host,
+		user:     user,
+		password: password,
+		mounts:   mounts,
+		http:     http,
+	}, nil
+}
+
+func (c *client) Mounts() []string {
+	return c.mounts
+}
+
+func (c *client) hasMount(mount string) bool {
+	for _, m := range c.mounts {
+		if m == mount {
+			return true
+		}
+	}
+	return false
+}
+
+// sid returns the stream ID of a mount, or "" if it isn't numeric.
+func sid(mount string) string {
+	id := strings.TrimPrefix(mount, "/")
+	if id == "" {
+		return ""
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	return id
+}
+
+// ListsListeners reports whether an admin password is set: the listener
+// list is only served to the admin.
+func (c *client) ListsListeners() bool {
+	return c.password != ""
+}
+
+func (c *client) Breaker() *breaker.Breaker {
+	return c.http.Breaker()
+}
+
+func (c *client) request(ctx context.Context, url string, admin bool) ([]byte, error) {
+	if !admin {
+		return c.http.Get(ctx, c.host+url, "", "")
+	}
+	return c.http.Get(ctx, c.host+url, c.user, c.password)
+}
@@ -0,0 +1,51 @@
+package shoutcast
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hub/internal/infrastructure/streaming"
+	"strings"
+)
+
+// streamListener is an entry of /admin.cgi?mode=viewjson&page=3.
+type streamListener struct {
+	UID         int    `json:"uid"`
+	Hostname    string `json:"hostname"`
+	UserAgent   string `json:"useragent"`
+	ConnectTime int    `json:"connecttime"`
+}
+
+func (c *client) ListClients(ctx context.Context, mount string) (*streaming.ClientList, error) {
+	if !c.hasMount(mount) {
+		return nil, fmt.Errorf("mount %s: %w", mount, streaming.ErrMountNotFound)
+	}
+	if !c.ListsListeners() {
+		return nil, fmt.Errorf("mount %s: %w", mount, streaming.ErrListenersUnavailable)
+	}
+
+	body, err := c.request(ctx, "/admin.cgi?mode=viewjson&page=3&sid="+sid(mount), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var listeners []streamListener
+	if err := json.Unmarshal(body, &listeners); err != nil {
+		return nil, fmt.Errorf("failed to parse listeners JSON: %w", err)
+	}
+
+	resp := &streaming.ClientList{
+		Count:     len(listeners),
+		Listeners: make([]streaming.Listener, 0, len(listeners)),
+	}
+	for _, l := range listeners {
+		resp.Listeners = append(resp.Listeners, streaming.Listener{
+			ID:        l.UID,
+			IP:        strings.TrimSpace(l.Hostname),
+			UserAgent: strings.TrimSpace(l.UserAgent),
+			Connected: l.ConnectTime,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package shoutcast
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hub/internal/infrastructure/streaming"
+	"time"
+)
+
+// streamStats is the JSON of /stats?sid=N&json=1. Bitrate and sample rate
+// are numbers, numeric strings or empty depending on the server build and
+// the source.
+type streamStats struct {
+	ServerTitle       string               `json:"servertitle"`
+	ServerDescription string               `json:"serverdescription"`
+	ServerGenre       string               `json:"servergenre"`
+	ServerURL         string               `json:"serverurl"`
+	SongTitle         string               `json:"songtitle"`
+	CurrentListeners  int                  `json:"currentlisteners"`
+	PeakListeners     int                  `json:"peaklisteners"`
+	Bitrate           streaming.FlexString `json:"bitrate"`
+	SampleRate        streaming.FlexString `json:"samplerate"`
+	StreamStatus      int                  `json:"streamstatus"`
+	StreamUptime      int64                `json:"streamuptime"`
+}
+
+func (c *client) MountStats(ctx context.Context) ([]*streaming.SourceStats, error) {
+	live := make([]*streaming.SourceStats, 0, len(c.mounts))
+	for _, mount := range c.mounts {
+		body, err := c.request(ctx, "/stats?json=1&sid="+sid(mount), false)
+		if err != nil {
+			return nil, fmt.Errorf("stream %s: %w", mount, err)
+		}
+
+		var stats streamStats
+		if err := json.Unmarshal(body, &stats); err != nil {
+			return nil, fmt.Errorf("failed to parse stats JSON: %w", err)
+		}
+
+		// A stream without a connected source reports zero listeners
+		// rather than disappearing like an Icecast mount.
+		if stats.StreamStatus != 1 {
+			continue
+		}
+
+		live = append(live, stats.source(mount))
+	}
+
+	return live, nil
+}
+
+func (s streamStats) source(mount string) *streaming.SourceStats {
+	source := &streaming.SourceStats{
+		Mount:        mount,
+		Name:         s.ServerTitle,
+		Description:  s.ServerDescription,
+		StreamURL:    s.ServerURL,
+		Listeners:    s.CurrentListeners,
+		ListenerPeak: s.PeakListeners,
+		Genre:        s.ServerGenre,
+		Bitrate:      string(s.Bitrate),
+		Title:        s.SongTitle,
+	}
+	if s.StreamUptime > 0 {
+		start := time.Now().Add(-time.Duration(s.StreamUptime) * time.Second)
+		source.StreamStart = start.Format(time.RFC1123Z)
+	}
+	if s.SampleRate != "" {
+		source.AudioInfo = "samplerate=" + string(s.SampleRate)
+		if s.Bitrate != "" {
+			source.AudioInfo = "bitrate=" + string(s.Bitrate) + ";" + source.AudioInfo
+		}
+	}
+	return source
+}
@@ -0,0 +1,127 @@
+// Package streaming defines the client every streaming server adapter
+// implements and the shapes it reports, so the services built on it don't
+// depend on the server a station runs.
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hub/internal/infrastructure/breaker"
+	"regexp"
+)
+
+var (
+	ErrMountNotFound  = errors.New("mount not found")
+	ErrUnknownStation = errors.New("no streaming client for station")
+
+	// ErrListenersUnavailable is returned by clients that cannot list
+	// individual listeners, such as the Icecast status-json.xsl client.
+	ErrListenersUnavailable = errors.New("listener list unavailable")
+)
+
+var trackIDPattern = regexp.MustCompile(`\[([a-f0-9]{32})\]$`)
+
+type (
+	Client interface {
+		// Mounts returns the configured mounts, the primary one first.
+		Mounts() []string
+
+		// MountStats returns the stats of the configured mounts that are
+		// live, in configured order.
+		MountStats(ctx context.Context) ([]*SourceStats, error)
+
+		// ListClients returns the listeners of a configured mount.
+		// Returns ErrListenersUnavailable if the server doesn't expose them.
+		ListClients(ctx context.Context, mount string) (*ClientList, error)
+
+		// ListsListeners reports whether ListClients can list listeners at
+		// all, as set up; it makes no request.
+		ListsListeners() bool
+
+		// Breaker returns the circuit breaker guarding the server.
+		Breaker() *breaker.Breaker
+	}
+
+	// Clients holds the streaming server client of every station, by
+	// station ID.
+	Clients map[int64]Client
+
+	SourceStats struct {
+		Mount        string
+		Name         string
+		Description  string
+		StreamURL    string
+		Listeners    int
+		ListenerPeak int
+		Genre        string
+		Bitrate      string
+		Title        string
+		StreamStart  string
+		MetadataURL  string
+		AudioInfo    string
+	}
+
+	ClientList struct {
+		Count     int
+		Listeners []Listener
+	}
+
+	Listener struct {
+		ID        int
+		IP        string
+		UserAgent string
+		Connected int
+	}
+)
+
+// Get returns the client of a station.
+func (c Clients) Get(stationID int64) (Client, error) {
+	client, ok := c[stationID]
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrUnknownStation, stationID)
+	}
+	return client, nil
+}
+
+// Breakers returns the circuit breaker of every station's server.
+func (c Clients) Breakers() []*breaker.Breaker {
+	breakers := make([]*breaker.Breaker, 0, len(c))
+	for _, client := range c {
+		breakers = append(breakers, client.Breaker())
+	}
+	return breakers
+}
+
+// MergeClientLists combines the client lists of mounts of the same channel.
+// A listener also connected to an earlier mount, identified by IP and user
+// agent, is counted there only.
+func MergeClientLists(lists ...*ClientList) *ClientList {
+	if len(lists) == 1 {
+		return lists[0]
+	}
+
+	merged := &ClientList{}
+	owner := make(map[string]int)
+	for i, list := range lists {
+		for _, l := range list.Listeners {
+			identity := l.IP + "|" + l.UserAgent
+			if mount, ok := owner[identity]; ok && mount != i {
+				continue
+			}
+			owner[identity] = i
+			merged.Listeners = append(merged.Listeners, l)
+		}
+	}
+	merged.Count = len(merged.Listeners)
+	return merged
+}
+
+// ExtractTrackID extracts MD5 track ID from title format: "Artist - Title [MD5]"
+func ExtractTrackID(title string) string {
+	matches := trackIDPattern.FindStringSubmatch(title)
+	if len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
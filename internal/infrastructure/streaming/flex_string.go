@@ -0,0 +1,29 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// FlexString decodes JSON fields a server renders as a number or a string
+// depending on its value or build, such as a bitrate or a numeric title.
+// null decodes to "".
+type FlexString string
+
+func (f *FlexString) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*f = FlexString(v)
+		return nil
+	}
+	if bytes.Equal(data, []byte("null")) {
+		*f = ""
+		return nil
+	}
+	*f = FlexString(strings.TrimSpace(string(data)))
+	return nil
+}
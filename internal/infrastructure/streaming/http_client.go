@@ -0,0 +1,127 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hub/internal/infrastructure/breaker"
+	"hub/internal/infrastructure/retry"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxRetryBackoff caps the delay between retries of a request.
+const maxRetryBackoff = 2 * time.Second
+
+// HTTPClient sends the GET requests of a streaming server client. Each
+// attempt times out, transport errors and 5xx responses are retried with
+// jittered backoff, and a circuit breaker stops calling a server that keeps
+// failing.
+type HTTPClient struct {
+	client  *http.Client
+	retries int
+	backoff time.Duration
+	breaker *breaker.Breaker
+}
+
+// statusError is a non-200 response of the server.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("streaming server returned status %d", e.code)
+}
+
+// NewHTTPClient creates an HTTPClient. A nil breaker never opens.
+func NewHTTPClient(timeout time.Duration, retries int, backoff time.Duration, br *breaker.Breaker) *HTTPClient {
+	if br == nil {
+		br = breaker.New("", 0, 0, nil)
+	}
+	return &HTTPClient{
+		client:  &http.Client{Timeout: timeout},
+		retries: retries,
+		backoff: backoff,
+		breaker: br,
+	}
+}
+
+// RequestBudget returns the longest a request can take before Get gives up:
+// every attempt timing out, with the longest backoff between them.
+func RequestBudget(timeout time.Duration, retries int, backoff time.Duration) time.Duration {
+	budget := time.Duration(retries+1) * timeout
+	for attempt := 1; attempt <= retries; attempt++ {
+		d := maxRetryBackoff
+		if attempt < 32 && backoff<<(attempt-1) < maxRetryBackoff {
+			d = backoff << (attempt - 1)
+		}
+		budget += d
+	}
+	return budget
+}
+
+// Breaker returns the circuit breaker guarding the server.
+func (h *HTTPClient) Breaker() *breaker.Breaker {
+	return h.breaker
+}
+
+// Get fetches a URL, with basic auth when user is set.
+func (h *HTTPClient) Get(ctx context.Context, url, user, password string) ([]byte, error) {
+	if err := h.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		body, err := h.get(ctx, url, user, password)
+		if err == nil {
+			h.breaker.Record(nil)
+			return body, nil
+		}
+
+		var status *statusError
+		if errors.As(err, &status) && status.code < 500 {
+			// The server answered; the request itself is wrong
+			h.breaker.Record(nil)
+			return nil, err
+		}
+		if attempt > h.retries || ctx.Err() != nil {
+			h.breaker.Record(err)
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			h.breaker.Record(ctx.Err())
+			return nil, err
+		case <-time.After(retry.Backoff(attempt, h.backoff, maxRetryBackoff)):
+		}
+	}
+}
+
+func (h *HTTPClient) get(ctx context.Context, url, user, password string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if user != "" {
+		req.SetBasicAuth(user, password)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Can't send request to streaming server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, nil
+}
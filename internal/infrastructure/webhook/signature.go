@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers set on every delivery.
+const (
+	HeaderEvent     = "X-Hub-Event"
+	HeaderStation   = "X-Hub-Station"
+	HeaderDelivery  = "X-Hub-Delivery"
+	HeaderTimestamp = "X-Hub-Timestamp"
+	HeaderSignature = "X-Hub-Signature"
+)
+
+// Sign returns the signature header value of a delivery body:
+// "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>".
+// Binding the timestamp lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
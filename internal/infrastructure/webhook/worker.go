@@ -0,0 +1,208 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"hub/internal/domain/webhook"
+	"hub/internal/infrastructure/retry"
+	"hub/internal/logger"
+)
+
+const (
+	// batchSize is how many deliveries are claimed per poll.
+	batchSize = 50
+	// errorBodyLimit caps how much of a failed response is kept in the log.
+	errorBodyLimit = 512
+
+	baseBackoff = 5 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// Worker posts pending webhook deliveries, retrying failures with
+// exponential backoff until maxAttempts, then dead-lettering them.
+type Worker struct {
+	repo        webhook.Repository
+	deliveries  webhook.DeliveryRepository
+	client      *http.Client
+	logger      *logger.Logger
+	interval    time.Duration
+	timeout     time.Duration
+	maxAttempts int
+
+	isStarted atomic.Bool
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewWorker creates a new Worker.
+func NewWorker(
+	repo webhook.Repository,
+	deliveries webhook.DeliveryRepository,
+	interval, timeout time.Duration,
+	maxAttempts int,
+	log *logger.Logger,
+) *Worker {
+	return &Worker{
+		repo:        repo,
+		deliveries:  deliveries,
+		client:      &http.Client{Timeout: timeout},
+		logger:      log,
+		interval:    interval,
+		timeout:     timeout,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Start starts polling for deliveries in the background.
+func (w *Worker) Start() {
+	if !w.isStarted.CompareAndSwap(false, true) {
+		w.logger.Warn("Webhook worker already started")
+		return
+	}
+
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go w.run()
+	w.logger.Infof("Webhook worker started - polling every %s", w.interval)
+}
+
+// Stop stops the worker after the batch in progress.
+func (w *Worker) Stop(ctx context.Context) error {
+	if !w.isStarted.Load() {
+		return nil
+	}
+
+	close(w.stop)
+
+	select {
+	case <-w.done:
+		w.isStarted.Store(false)
+		w.logger.Info("Webhook worker stopped gracefully")
+		return nil
+	case <-ctx.Done():
+		w.logger.Warn("Webhook worker stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (w *Worker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.deliverBatch(context.Background()); err != nil {
+			w.logger.WithContext("webhook", "deliver").WithError(err).Error("failed to deliver webhook batch")
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) deliverBatch(ctx context.Context) error {
+	// The lease outlasts a whole batch of timed-out requests
+	deliveries, err := w.deliveries.Claim(ctx, batchSize, batchSize*w.timeout)
+	if err != nil {
+		return err
+	}
+
+	webhooks := make(map[string]*webhook.Webhook)
+	for _, d := range deliveries {
+		hook, ok := webhooks[d.WebhookID()]
+		if !ok {
+			hook, err = w.repo.FindByID(ctx, d.WebhookID())
+			if err != nil {
+				// Deleted meanwhile: its deliveries went with it
+				if errors.Is(err, webhook.ErrWebhookNotFound) {
+					continue
+				}
+				return err
+			}
+			webhooks[d.WebhookID()] = hook
+		}
+
+		w.deliver(ctx, hook, d)
+	}
+
+	return nil
+}
+
+func (w *Worker) deliver(ctx context.Context, hook *webhook.Webhook, d *webhook.Delivery) {
+	log := w.logger.WithContext("webhook", "deliver").
+		WithField("webhook_id", hook.ID()).
+		WithField("delivery_id", d.ID()).
+		WithField("event", d.EventName())
+
+	status, err := w.post(ctx, hook, d)
+	if err == nil {
+		if err := w.deliveries.MarkSucceeded(ctx, d.ID(), status); err != nil {
+			log.WithError(err).Error("failed to record webhook delivery")
+		}
+		return
+	}
+
+	attempts := d.Attempts() + 1
+	dead := attempts >= w.maxAttempts
+	if dead {
+		log.WithError(err).Errorf("dead-lettering webhook delivery after %d attempts", attempts)
+	} else {
+		log.WithError(err).Warnf("webhook delivery failed, attempt %d of %d", attempts, w.maxAttempts)
+	}
+
+	next := time.Now().Add(retry.Backoff(attempts, baseBackoff, maxBackoff))
+	if err := w.deliveries.MarkFailed(ctx, d.ID(), status, err.Error(), next, dead); err != nil {
+		log.WithError(err).Error("failed to record webhook delivery failure")
+	}
+}
+
+// post sends a delivery and returns the response status.
+// Any non-2xx response is an error.
+func (w *Worker) post(ctx context.Context, hook *webhook.Webhook, d *webhook.Delivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL(), bytes.NewReader(d.Payload()))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "hub-webhooks")
+	req.Header.Set(HeaderEvent, d.EventName())
+	if d.Station() != "" {
+		req.Header.Set(HeaderStation, d.Station())
+	}
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(d.ID(), 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret(), timestamp, d.Payload()))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyLimit))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	// Drain so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
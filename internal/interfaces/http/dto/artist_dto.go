@@ -0,0 +1,27 @@
+package dto
+
+// ArtistResponse represents an artist with totals over their tracks.
+type ArtistResponse struct {
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	Tracks    int    `json:"tracks"`
+	Rotate    int    `json:"rotate"`
+	Likes     int    `json:"likes"`
+	Dislikes  int    `json:"dislikes"`
+	Listeners int    `json:"listeners"`
+}
+
+// ArtistListResponse represents a page of artists.
+type ArtistListResponse struct {
+	Items   []*ArtistResponse `json:"items"`
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+	HasMore bool              `json:"hasMore"`
+}
+
+// ArtistDetailResponse represents an artist page: totals plus a page of their tracks.
+type ArtistDetailResponse struct {
+	ArtistResponse
+	Items      []*GetTrackResponse `json:"items"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+}
@@ -19,11 +19,20 @@ var (
 	ErrBadRequest = func(msg string) ErrorResponse {
 		return NewErrorResponse("bad_request", msg)
 	}
+	ErrUnauthorized = func(msg string) ErrorResponse {
+		return NewErrorResponse("unauthorized", msg)
+	}
+	ErrForbidden = func(msg string) ErrorResponse {
+		return NewErrorResponse("forbidden", msg)
+	}
 	ErrNotFound = func(msg string) ErrorResponse {
 		return NewErrorResponse("not_found", msg)
 	}
 	ErrConflict = func(msg string) ErrorResponse {
 		return NewErrorResponse("conflict", msg)
 	}
+	ErrTooManyRequests = func(msg string) ErrorResponse {
+		return NewErrorResponse("too_many_requests", msg)
+	}
 	ErrInternalServer = NewErrorResponse("internal_error", "Internal server error")
 )
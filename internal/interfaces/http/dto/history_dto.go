@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// PlayResponse represents a single play in HTTP response.
+type PlayResponse struct {
+	TrackID        string     `json:"trackId"`
+	Title          string     `json:"title"`
+	Cover          string     `json:"cover"`
+	StartedAt      time.Time  `json:"startedAt"`
+	EndedAt        *time.Time `json:"endedAt"`
+	ListenersStart int        `json:"listenersStart"`
+	ListenersEnd   *int       `json:"listenersEnd"`
+}
+
+// HistoryResponse represents a page of play history in HTTP response.
+type HistoryResponse struct {
+	Items      []*PlayResponse `json:"items"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
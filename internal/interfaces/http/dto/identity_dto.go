@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+// IdentityResponse represents a newly issued anonymous identity.
+type IdentityResponse struct {
+	Token     string     `json:"token"`
+	UserID    string     `json:"userId"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// JobResponse represents a scheduled job in HTTP response.
+type JobResponse struct {
+	Name      string          `json:"name"`
+	Spec      string          `json:"spec"`
+	Enabled   bool            `json:"enabled"`
+	Overlap   string          `json:"overlap"`
+	Timeout   string          `json:"timeout"`
+	Running   bool            `json:"running"`
+	NextRunAt *time.Time      `json:"nextRunAt,omitempty"`
+	LastRun   *JobRunResponse `json:"lastRun,omitempty"`
+}
+
+// JobRunResponse represents a job run in HTTP response.
+type JobRunResponse struct {
+	ID         int64      `json:"id"`
+	Trigger    string     `json:"trigger"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // ListenerResponse represents listener count in HTTP response.
 type ListenerResponse struct {
 	Current int `json:"current"`
@@ -13,3 +15,22 @@ type RadioResponse struct {
 	StreamUrl   string           `json:"streamUrl"`
 	Listener    ListenerResponse `json:"listener"`
 }
+
+// MountResponse represents a configured mount in HTTP response.
+// Stream details are empty while the mount is not live.
+type MountResponse struct {
+	Mount       string           `json:"mount"`
+	Live        bool             `json:"live"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	StreamUrl   string           `json:"streamUrl"`
+	Bitrate     string           `json:"bitrate"`
+	Listener    ListenerResponse `json:"listener"`
+}
+
+// NowPlayingResponse represents a now-playing stream frame.
+type NowPlayingResponse struct {
+	Track     *GetTrackResponse `json:"track"`
+	Listeners int               `json:"listeners"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
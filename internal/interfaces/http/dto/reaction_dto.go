@@ -6,8 +6,20 @@ type ReactionRequest struct {
 	TrackID string `json:"trackId" validate:"required"`
 }
 
+// ChangeReactionRequest represents the HTTP request to switch a reaction.
+type ChangeReactionRequest struct {
+	Reaction string `json:"reaction" validate:"required"`
+}
+
 // CheckReactionResponse represents the HTTP response for checking a reaction.
 type CheckReactionResponse struct {
 	HasReacted bool   `json:"hasReacted"`
 	Reaction   string `json:"reaction,omitempty"`
 }
+
+// ReactionTypeResponse represents a configured reaction type.
+type ReactionTypeResponse struct {
+	Key   string `json:"key"`
+	Emoji string `json:"emoji"`
+	Label string `json:"label"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// StationResponse represents a station in HTTP response.
+type StationResponse struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
@@ -3,11 +3,15 @@ package dto
 // TrackStats represents track statistics in HTTP response.
 type TrackStats struct {
 	Title     string `json:"title"`
+	Artist    string `json:"artist"`
+	Slug      string `json:"slug,omitempty"`
+	Song      string `json:"song"`
 	Cover     string `json:"cover"`
 	Rotate    int    `json:"rotate"`
 	Likes     int    `json:"likes"`
 	Dislikes  int    `json:"dislikes"`
 	Listeners int    `json:"listeners"`
+	Count     int    `json:"count,omitempty"`
 }
 
 // StatisticCategory represents a statistics category.
@@ -16,9 +20,27 @@ type StatisticCategory struct {
 	Description string        `json:"description,omitempty"`
 	Icon        string        `json:"icon"`
 	Tracks      []*TrackStats `json:"tracks"`
+	Limit       int           `json:"limit"`
+	Offset      int           `json:"offset"`
+	HasMore     bool          `json:"hasMore"`
 }
 
 // StatisticsResponse represents the HTTP response for statistics.
 type StatisticsResponse struct {
 	Statistics []*StatisticCategory `json:"statistics"`
 }
+
+// ListeningResponse represents the listener session summary in HTTP response.
+type ListeningResponse struct {
+	Sessions              int                 `json:"sessions"`
+	AverageSessionSeconds int                 `json:"averageSessionSeconds"`
+	ListeningHours        float64             `json:"listeningHours"`
+	Days                  []*ListeningDayStat `json:"days"`
+}
+
+// ListeningDayStat represents the listening time of one day.
+type ListeningDayStat struct {
+	Date     string  `json:"date"`
+	Sessions int     `json:"sessions"`
+	Hours    float64 `json:"hours"`
+}
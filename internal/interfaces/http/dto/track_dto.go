@@ -1,6 +1,9 @@
 package dto
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // CreateTrackRequest represents the HTTP request to create/update a track.
 type CreateTrackRequest struct {
@@ -30,11 +33,25 @@ type TrackResponse struct {
 
 // GetTrackResponse represents the HTTP response for getting a track.
 type GetTrackResponse struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Cover     string `json:"cover"`
-	Rotate    int    `json:"rotate"`
-	Likes     int    `json:"likes"`
-	Dislikes  int    `json:"dislikes"`
-	Listeners int    `json:"listeners"`
+	ID        string         `json:"id"`
+	Title     string         `json:"title"`
+	Artist    string         `json:"artist"`
+	Song      string         `json:"song"`
+	Featuring []string       `json:"featuring"`
+	Cover     string         `json:"cover"`
+	Rotate    int            `json:"rotate"`
+	Likes     int            `json:"likes"`
+	Dislikes  int            `json:"dislikes"`
+	Listeners int            `json:"listeners"`
+	Reactions map[string]int `json:"reactions,omitempty"`
+	// Share of listeners present at the start of a play who tuned out early, 0 to 1
+	TuneOutRate *float64  `json:"tuneOutRate,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// TrackListResponse represents a page of the track catalogue.
+type TrackListResponse struct {
+	Items      []*GetTrackResponse `json:"items"`
+	NextCursor string              `json:"nextCursor,omitempty"`
 }
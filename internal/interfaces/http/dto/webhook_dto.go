@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CreateWebhookRequest represents the HTTP request to create a webhook.
+type CreateWebhookRequest struct {
+	URL     string   `json:"url" validate:"required"`
+	Events  []string `json:"events" validate:"required"`
+	Station string   `json:"station,omitempty"`
+}
+
+// WebhookResponse represents a webhook in HTTP response.
+type WebhookResponse struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Station   string    `json:"station,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreateWebhookResponse represents a newly created webhook with its signing secret.
+type CreateWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryResponse represents a delivery log entry in HTTP response.
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	Event          string          `json:"event"`
+	Station        string          `json:"station,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus *int            `json:"responseStatus,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time       `json:"nextAttemptAt"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty"`
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+
+	appartist "hub/internal/application/artist"
+	appshared "hub/internal/application/shared"
+	apptrack "hub/internal/application/track"
+	"hub/internal/domain/artist"
+	"hub/internal/domain/track"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ArtistHandler handles HTTP requests for artists.
+type ArtistHandler struct {
+	listHandler       *appartist.ListArtistsHandler
+	getHandler        *appartist.GetArtistHandler
+	listTracksHandler *apptrack.ListTracksHandler
+}
+
+// NewArtistHandler creates a new ArtistHandler.
+func NewArtistHandler(
+	listHandler *appartist.ListArtistsHandler,
+	getHandler *appartist.GetArtistHandler,
+	listTracksHandler *apptrack.ListTracksHandler,
+) *ArtistHandler {
+	return &ArtistHandler{
+		listHandler:       listHandler,
+		getHandler:        getHandler,
+		listTracksHandler: listTracksHandler,
+	}
+}
+
+// List handles artist listing and search requests.
+func (h *ArtistHandler) List(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit")
+	offset := c.QueryInt("offset")
+	if limit < 0 || offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("'limit' and 'offset' must not be negative"))
+	}
+
+	result, err := h.listHandler.Handle(c.Context(), appartist.ListArtistsQuery{
+		Search: c.Query("q"),
+		Sort:   c.Query("sort"),
+		Order:  c.Query("order"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	items := make([]*dto.ArtistResponse, len(result.Artists))
+	for i, a := range result.Artists {
+		items[i] = toArtistResponse(a)
+	}
+
+	return c.JSON(dto.ArtistListResponse{
+		Items:   items,
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+		HasMore: result.HasMore,
+	})
+}
+
+// Get handles artist page requests: totals plus a page of the artist's tracks.
+// Tracks default to the most rotated first; further pages follow nextCursor.
+func (h *ArtistHandler) Get(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+
+	a, err := h.getHandler.Handle(c.Context(), appartist.GetArtistQuery{Slug: slug})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	sort := c.Query("sort")
+	if sort == "" {
+		sort = track.SortByRotate.String()
+	}
+
+	tracks, err := h.listTracksHandler.Handle(c.Context(), apptrack.ListTracksQuery{
+		Artist: a.Slug,
+		Sort:   sort,
+		Order:  c.Query("order"),
+		Limit:  c.QueryInt("limit"),
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	items := make([]*dto.GetTrackResponse, len(tracks.Tracks))
+	for i, t := range tracks.Tracks {
+		items[i] = toGetTrackResponse(t)
+	}
+
+	return c.JSON(dto.ArtistDetailResponse{
+		ArtistResponse: *toArtistResponse(a),
+		Items:          items,
+		NextCursor:     tracks.NextCursor,
+	})
+}
+
+// handleError maps domain errors to HTTP responses.
+func (h *ArtistHandler) handleError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, artist.ErrInvalidSlug):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid artist slug"))
+	case errors.Is(err, artist.ErrArtistNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Artist not found"))
+	case errors.Is(err, artist.ErrInvalidSortField), errors.Is(err, track.ErrInvalidSortField):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid sort field"))
+	case errors.Is(err, appartist.ErrInvalidSortOrder), errors.Is(err, apptrack.ErrInvalidSortOrder):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid sort order"))
+	case errors.Is(err, appshared.ErrInvalidCursor):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid cursor"))
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+}
+
+func toArtistResponse(a *appartist.ArtistDTO) *dto.ArtistResponse {
+	return &dto.ArtistResponse{
+		Slug:      a.Slug,
+		Name:      a.Name,
+		Tracks:    a.Tracks,
+		Rotate:    a.Rotate,
+		Likes:     a.Likes,
+		Dislikes:  a.Dislikes,
+		Listeners: a.Listeners,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"hub/internal/infrastructure/breaker"
 	"hub/internal/interfaces/http/dto"
 
 	"github.com/gofiber/fiber/v2"
@@ -12,17 +13,31 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// LeaderStatus reports this replica's role in the scheduler leader election.
+type LeaderStatus interface {
+	Role() string
+}
+
+// CircuitBreakers reports the circuit breakers of the streaming servers.
+type CircuitBreakers interface {
+	Breakers() []*breaker.Breaker
+}
+
 // HealthHandler handles health check requests.
 type HealthHandler struct {
-	db    *pgxpool.Pool
-	redis *redis.Client
+	db       *pgxpool.Pool
+	redis    *redis.Client
+	leader   LeaderStatus
+	breakers CircuitBreakers
 }
 
 // NewHealthHandler creates a new HealthHandler.
-func NewHealthHandler(db *pgxpool.Pool, redis *redis.Client) *HealthHandler {
+func NewHealthHandler(db *pgxpool.Pool, redis *redis.Client, leader LeaderStatus, breakers CircuitBreakers) *HealthHandler {
 	return &HealthHandler{
-		db:    db,
-		redis: redis,
+		db:       db,
+		redis:    redis,
+		leader:   leader,
+		breakers: breakers,
 	}
 }
 
@@ -48,6 +63,15 @@ func (h *HealthHandler) Health(c *fiber.Ctx) error {
 		}
 	}
 
+	// Informational only: followers are healthy too
+	checks["scheduler"] = dto.NewCheck(h.leader.Role(), "", "")
+
+	// Informational too: an unreachable streaming server doesn't make this
+	// replica unhealthy
+	for _, b := range h.breakers.Breakers() {
+		checks["streaming_"+b.Name()] = dto.NewCheck(b.State().String(), "", "")
+	}
+
 	status := "healthy"
 	statusCode := fiber.StatusOK
 	if !allHealthy {
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	appplay "hub/internal/application/play"
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/play"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HistoryHandler handles HTTP requests for play history.
+type HistoryHandler struct {
+	getHandler *appplay.GetHistoryHandler
+}
+
+// NewHistoryHandler creates a new HistoryHandler.
+func NewHistoryHandler(getHandler *appplay.GetHistoryHandler) *HistoryHandler {
+	return &HistoryHandler{getHandler: getHandler}
+}
+
+// GetHistory handles get play history requests.
+func (h *HistoryHandler) GetHistory(c *fiber.Ctx) error {
+	from, err := parseTimeQuery(c, "from")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("'from' must be an RFC 3339 timestamp"))
+	}
+
+	to, err := parseTimeQuery(c, "to")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("'to' must be an RFC 3339 timestamp"))
+	}
+
+	result, err := h.getHandler.Handle(c.Context(), appplay.GetHistoryQuery{
+		From:   from,
+		To:     to,
+		Limit:  c.QueryInt("limit"),
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	items := make([]*dto.PlayResponse, len(result.Plays))
+	for i, p := range result.Plays {
+		items[i] = &dto.PlayResponse{
+			TrackID:        p.TrackID,
+			Title:          p.Title,
+			Cover:          p.Cover,
+			StartedAt:      p.StartedAt,
+			EndedAt:        p.EndedAt,
+			ListenersStart: p.ListenersStart,
+			ListenersEnd:   p.ListenersEnd,
+		}
+	}
+
+	return c.JSON(dto.HistoryResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	})
+}
+
+// handleError maps domain errors to HTTP responses.
+func (h *HistoryHandler) handleError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, play.ErrInvalidTimeRange):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("'from' must be before 'to'"))
+	case errors.Is(err, appshared.ErrInvalidCursor):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid cursor"))
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+}
+
+// parseTimeQuery parses an optional RFC 3339 query parameter.
+func parseTimeQuery(c *fiber.Ctx, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"hub/internal/application/identity"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// IdentityHandler handles HTTP requests for anonymous listener identities.
+type IdentityHandler struct {
+	service identity.Service
+}
+
+// NewIdentityHandler creates a new IdentityHandler.
+func NewIdentityHandler(svc identity.Service) *IdentityHandler {
+	return &IdentityHandler{service: svc}
+}
+
+// Issue handles identity token requests.
+func (h *IdentityHandler) Issue(c *fiber.Ctx) error {
+	token, err := h.service.Issue(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.IdentityResponse{
+		Token:     token.Value,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+	})
+}
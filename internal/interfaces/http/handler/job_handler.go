@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"errors"
+
+	"hub/internal/infrastructure/scheduler"
+	"hub/internal/infrastructure/scheduler/job"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// JobHandler handles HTTP requests for scheduled job administration.
+type JobHandler struct {
+	scheduler scheduler.Scheduler
+}
+
+// NewJobHandler creates a new JobHandler.
+func NewJobHandler(scheduler scheduler.Scheduler) *JobHandler {
+	return &JobHandler{scheduler: scheduler}
+}
+
+// List handles list jobs requests.
+func (h *JobHandler) List(c *fiber.Ctx) error {
+	jobs, err := h.scheduler.Jobs(c.Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	response := make([]dto.JobResponse, len(jobs))
+	for i, j := range jobs {
+		response[i] = dto.JobResponse{
+			Name:      j.Name,
+			Spec:      j.Options.Spec,
+			Enabled:   j.Options.Enabled,
+			Overlap:   j.Options.Overlap,
+			Timeout:   j.Options.Timeout.String(),
+			Running:   j.Running,
+			NextRunAt: j.NextRun,
+		}
+		if j.LastRun != nil {
+			response[i].LastRun = &dto.JobRunResponse{
+				ID:         j.LastRun.ID,
+				Trigger:    j.LastRun.Trigger,
+				StartedAt:  j.LastRun.StartedAt,
+				FinishedAt: j.LastRun.FinishedAt,
+				Error:      j.LastRun.Error,
+			}
+		}
+	}
+
+	return c.JSON(response)
+}
+
+// Run handles manual job run requests. The job runs in the background.
+func (h *JobHandler) Run(c *fiber.Ctx) error {
+	if err := h.scheduler.Trigger(c.Context(), c.Params("name")); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+// handleError maps scheduler errors to HTTP responses.
+func (h *JobHandler) handleError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, job.ErrJobNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Job not found"))
+	case errors.Is(err, job.ErrJobRunning):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrConflict("Job is already running"))
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+}
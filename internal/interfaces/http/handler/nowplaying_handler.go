@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"hub/internal/application/nowplaying"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// nowPlayingEvent is the SSE event name of now-playing frames.
+	nowPlayingEvent = "now-playing"
+	// heartbeatInterval keeps idle connections open through proxies.
+	heartbeatInterval = 15 * time.Second
+	// retryInterval is the reconnect delay suggested to clients, in milliseconds.
+	retryInterval = 3000
+)
+
+// NowPlayingHandler handles the now-playing Server-Sent Events stream.
+type NowPlayingHandler struct {
+	broadcaster *nowplaying.Broadcaster
+}
+
+// NewNowPlayingHandler creates a new NowPlayingHandler.
+func NewNowPlayingHandler(broadcaster *nowplaying.Broadcaster) *NowPlayingHandler {
+	return &NowPlayingHandler{broadcaster: broadcaster}
+}
+
+// Stream pushes a frame whenever the track on air, its reactions or the
+// listener count change. Reconnecting clients resume through Last-Event-ID.
+func (h *NowPlayingHandler) Stream(c *fiber.Ctx) error {
+	lastEventID := c.Get("Last-Event-ID")
+	if lastEventID == "" {
+		// EventSource can't set headers on the first connection
+		lastEventID = c.Query("lastEventId")
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	sub := h.broadcaster.Subscribe(c.Context(), lastEventID)
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		fmt.Fprintf(w, "retry: %d\n\n", retryInterval)
+		for _, frame := range sub.Replay {
+			writeNowPlayingFrame(w, frame)
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case frame, ok := <-sub.Frames():
+				if !ok {
+					return
+				}
+				writeNowPlayingFrame(w, frame)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+
+			// A failed flush means the client went away
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+func writeNowPlayingFrame(w *bufio.Writer, frame nowplaying.Frame) {
+	resp := dto.NowPlayingResponse{
+		Listeners: frame.NowPlaying.Listeners,
+		UpdatedAt: frame.NowPlaying.UpdatedAt,
+	}
+	if frame.NowPlaying.Track != nil {
+		resp.Track = toGetTrackResponse(frame.NowPlaying.Track)
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", frame.ID, nowPlayingEvent, data)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"strings"
 
 	"hub/internal/application/radio"
 	"hub/internal/interfaces/http/dto"
@@ -50,8 +51,63 @@ func (h *RadioHandler) GetListen(c *fiber.Ctx) error {
 	})
 }
 
+// GetMounts handles list mounts requests.
+func (h *RadioHandler) GetMounts(c *fiber.Ctx) error {
+	mounts, err := h.service.GetMounts(c.Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	items := make([]dto.MountResponse, len(mounts))
+	for i, m := range mounts {
+		items[i] = dto.MountResponse{
+			Mount:       m.Mount,
+			Live:        m.Live,
+			Name:        m.Name,
+			Description: m.Description,
+			StreamUrl:   m.StreamUrl,
+			Bitrate:     m.Bitrate,
+			Listener: dto.ListenerResponse{
+				Current: m.Listeners,
+				Peak:    m.ListenerPeak,
+			},
+		}
+	}
+
+	return c.JSON(items)
+}
+
+// GetMountInfo handles get mount info requests. The mount is given without
+// its leading slash, e.g. /radio/mounts/mp3/info.
+func (h *RadioHandler) GetMountInfo(c *fiber.Ctx) error {
+	mount := c.Params("mount")
+	if mount == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Mount is required"))
+	}
+	if !strings.HasPrefix(mount, "/") {
+		mount = "/" + mount
+	}
+
+	info, err := h.service.GetMountInfo(c.Context(), mount)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(dto.RadioResponse{
+		Name:        info.Name,
+		Description: info.Description,
+		StreamUrl:   info.StreamUrl,
+		Listener: dto.ListenerResponse{
+			Current: info.Listeners,
+			Peak:    info.ListenerPeak,
+		},
+	})
+}
+
 func (h *RadioHandler) handleError(c *fiber.Ctx, err error) error {
 	switch {
+	case errors.Is(err, radio.ErrMountNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Mount not found"))
 	case errors.Is(err, radio.ErrNoActiveStream):
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("No active stream available"))
 	case errors.Is(err, radio.ErrInvalidResponse):
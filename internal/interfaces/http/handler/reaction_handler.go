@@ -7,42 +7,76 @@ import (
 	"hub/internal/domain/reaction"
 	"hub/internal/domain/track"
 	"hub/internal/interfaces/http/dto"
+	"hub/internal/interfaces/http/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 // ReactionHandler handles HTTP requests for reactions.
 type ReactionHandler struct {
-	addHandler   *appreaction.AddReactionHandler
-	checkHandler *appreaction.CheckReactionHandler
+	addHandler    *appreaction.AddReactionHandler
+	checkHandler  *appreaction.CheckReactionHandler
+	changeHandler *appreaction.ChangeReactionHandler
+	removeHandler *appreaction.RemoveReactionHandler
+	typesHandler  *appreaction.ListReactionTypesHandler
 }
 
 // NewReactionHandler creates a new ReactionHandler.
 func NewReactionHandler(
 	addHandler *appreaction.AddReactionHandler,
 	checkHandler *appreaction.CheckReactionHandler,
+	changeHandler *appreaction.ChangeReactionHandler,
+	removeHandler *appreaction.RemoveReactionHandler,
+	typesHandler *appreaction.ListReactionTypesHandler,
 ) *ReactionHandler {
 	return &ReactionHandler{
-		addHandler:   addHandler,
-		checkHandler: checkHandler,
+		addHandler:    addHandler,
+		checkHandler:  checkHandler,
+		changeHandler: changeHandler,
+		removeHandler: removeHandler,
+		typesHandler:  typesHandler,
 	}
 }
 
 // Like handles like requests.
 func (h *ReactionHandler) Like(c *fiber.Ctx) error {
-	return h.addReaction(c, "like")
+	return h.addReaction(c, reaction.Like.String())
 }
 
 // Dislike handles dislike requests.
 func (h *ReactionHandler) Dislike(c *fiber.Ctx) error {
-	return h.addReaction(c, "dislike")
+	return h.addReaction(c, reaction.Dislike.String())
+}
+
+// React handles reactions of any configured type.
+func (h *ReactionHandler) React(c *fiber.Ctx) error {
+	return h.addReaction(c, c.Params("type"))
+}
+
+// ListTypes handles list reaction types requests.
+func (h *ReactionHandler) ListTypes(c *fiber.Ctx) error {
+	types, err := h.typesHandler.Handle(c.Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	response := make([]*dto.ReactionTypeResponse, len(types))
+	for i, t := range types {
+		response[i] = &dto.ReactionTypeResponse{
+			Key:   t.Key,
+			Emoji: t.Emoji,
+			Label: t.Label,
+		}
+	}
+
+	return c.JSON(response)
 }
 
 // addReaction handles adding a reaction.
 func (h *ReactionHandler) addReaction(c *fiber.Ctx, reactionType string) error {
-	userID := c.Get("X-User-ID")
-	if userID == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("X-User-ID header is required"))
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Identity is required"))
 	}
 
 	trackID := c.Params("trackId")
@@ -51,7 +85,7 @@ func (h *ReactionHandler) addReaction(c *fiber.Ctx, reactionType string) error {
 	}
 
 	err := h.addHandler.Handle(c.Context(), appreaction.AddReactionCommand{
-		UserID:   userID,
+		UserID:   userID.String(),
 		TrackID:  trackID,
 		Reaction: reactionType,
 	})
@@ -65,9 +99,9 @@ func (h *ReactionHandler) addReaction(c *fiber.Ctx, reactionType string) error {
 
 // Check handles check reaction requests.
 func (h *ReactionHandler) Check(c *fiber.Ctx) error {
-	userID := c.Get("X-User-ID")
-	if userID == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("X-User-ID header is required"))
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Identity is required"))
 	}
 
 	trackID := c.Params("trackId")
@@ -76,7 +110,7 @@ func (h *ReactionHandler) Check(c *fiber.Ctx) error {
 	}
 
 	result, err := h.checkHandler.Handle(c.Context(), appreaction.CheckReactionQuery{
-		UserID:  userID,
+		UserID:  userID.String(),
 		TrackID: trackID,
 	})
 
@@ -90,6 +124,60 @@ func (h *ReactionHandler) Check(c *fiber.Ctx) error {
 	})
 }
 
+// Change handles switching an existing reaction to another type.
+func (h *ReactionHandler) Change(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Identity is required"))
+	}
+
+	trackID := c.Params("trackId")
+	if trackID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Track ID is required"))
+	}
+
+	var req dto.ChangeReactionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid request body"))
+	}
+
+	err := h.changeHandler.Handle(c.Context(), appreaction.ChangeReactionCommand{
+		UserID:   userID.String(),
+		TrackID:  trackID,
+		Reaction: req.Reaction,
+	})
+
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Remove handles retracting a reaction.
+func (h *ReactionHandler) Remove(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Identity is required"))
+	}
+
+	trackID := c.Params("trackId")
+	if trackID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Track ID is required"))
+	}
+
+	err := h.removeHandler.Handle(c.Context(), appreaction.RemoveReactionCommand{
+		UserID:  userID.String(),
+		TrackID: trackID,
+	})
+
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
 // handleError maps domain errors to HTTP responses.
 func (h *ReactionHandler) handleError(c *fiber.Ctx, err error) error {
 	switch {
@@ -99,6 +187,8 @@ func (h *ReactionHandler) handleError(c *fiber.Ctx, err error) error {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid user ID"))
 	case errors.Is(err, reaction.ErrReactionExists):
 		return c.Status(fiber.StatusConflict).JSON(dto.ErrConflict("User has already reacted to this track"))
+	case errors.Is(err, reaction.ErrReactionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Reaction not found"))
 	case errors.Is(err, reaction.ErrTrackNotFound):
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Track not found"))
 	case errors.Is(err, track.ErrInvalidTrackID):
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	appstation "hub/internal/application/station"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// StationHandler handles HTTP requests for stations.
+type StationHandler struct {
+	stations *appstation.Registry
+}
+
+// NewStationHandler creates a new StationHandler.
+func NewStationHandler(stations *appstation.Registry) *StationHandler {
+	return &StationHandler{stations: stations}
+}
+
+// List handles list stations requests.
+func (h *StationHandler) List(c *fiber.Ctx) error {
+	stations := h.stations.All()
+
+	response := make([]dto.StationResponse, len(stations))
+	for i, s := range stations {
+		response[i] = dto.StationResponse{
+			Slug:    s.Slug(),
+			Name:    s.Name(),
+			Default: s.IsDefault(),
+		}
+	}
+
+	return c.JSON(response)
+}
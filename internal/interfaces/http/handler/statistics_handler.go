@@ -1,6 +1,10 @@
 package handler
 
 import (
+	"errors"
+	"math"
+	"time"
+
 	"hub/internal/application/statistics"
 	"hub/internal/interfaces/http/dto"
 
@@ -19,31 +23,134 @@ func NewStatisticsHandler(svc statistics.Service) *StatisticsHandler {
 
 // GetStatistics handles get statistics requests.
 func (h *StatisticsHandler) GetStatistics(c *fiber.Ctx) error {
-	stats, err := h.service.GetStatistics(c.Context())
+	window, err := h.parseWindow(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+		return h.handleError(c, err)
 	}
 
-	response := make([]*dto.StatisticCategory, len(stats))
+	stats, err := h.service.GetStatistics(c.Context(), window)
+	if err != nil {
+		return h.handleError(c, err)
+	}
 
+	response := make([]*dto.StatisticCategory, len(stats))
 	for i, cat := range stats {
-		tracks := make([]*dto.TrackStats, len(cat.Tracks))
-		for j, t := range cat.Tracks {
-			tracks[j] = &dto.TrackStats{
-				Title:     t.Title,
-				Cover:     t.Cover,
-				Rotate:    t.Rotate,
-				Likes:     t.Likes,
-				Dislikes:  t.Dislikes,
-				Listeners: t.Listeners,
-			}
+		response[i] = toStatisticCategory(cat)
+	}
+
+	return c.JSON(response)
+}
+
+// GetCategory handles get single statistics category requests.
+func (h *StatisticsHandler) GetCategory(c *fiber.Ctx) error {
+	window, err := h.parseWindow(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	limit := c.QueryInt("limit")
+	offset := c.QueryInt("offset")
+	if limit < 0 || offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("'limit' and 'offset' must not be negative"))
+	}
+
+	cat, err := h.service.GetCategory(c.Context(), c.Params("key"), statistics.Page{
+		Window: window,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(toStatisticCategory(cat))
+}
+
+// GetListening handles listener session summary requests.
+func (h *StatisticsHandler) GetListening(c *fiber.Ctx) error {
+	window, err := h.parseWindow(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	listening, err := h.service.GetListening(c.Context(), window)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	days := make([]*dto.ListeningDayStat, len(listening.Days))
+	for i, d := range listening.Days {
+		days[i] = &dto.ListeningDayStat{
+			Date:     d.Date.Format(time.DateOnly),
+			Sessions: d.Sessions,
+			Hours:    roundHours(d.Hours),
 		}
-		response[i] = &dto.StatisticCategory{
-			Key:    cat.Key,
-			Icon:   cat.Icon,
-			Tracks: tracks,
+	}
+
+	return c.JSON(dto.ListeningResponse{
+		Sessions:              listening.Sessions,
+		AverageSessionSeconds: int(listening.AverageSession.Seconds()),
+		ListeningHours:        roundHours(listening.Hours),
+		Days:                  days,
+	})
+}
+
+// parseWindow resolves the period, from and to query parameters.
+func (h *StatisticsHandler) parseWindow(c *fiber.Ctx) (statistics.Window, error) {
+	from, err := parseTimeQuery(c, "from")
+	if err != nil {
+		return statistics.Window{}, statistics.ErrInvalidPeriod
+	}
+
+	to, err := parseTimeQuery(c, "to")
+	if err != nil {
+		return statistics.Window{}, statistics.ErrInvalidPeriod
+	}
+
+	return statistics.ResolveWindow(c.Query("period"), from, to, time.Now())
+}
+
+// handleError maps statistics errors to HTTP responses.
+func (h *StatisticsHandler) handleError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, statistics.ErrInvalidPeriod):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid period: use today, 7d, 30d, all or an RFC 3339 from/to range"))
+	case errors.Is(err, statistics.ErrCategoryNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Statistics category not found"))
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+}
+
+func toStatisticCategory(cat *statistics.Category) *dto.StatisticCategory {
+	tracks := make([]*dto.TrackStats, len(cat.Tracks))
+	for i, t := range cat.Tracks {
+		tracks[i] = &dto.TrackStats{
+			Title:     t.Title,
+			Artist:    t.Artist,
+			Slug:      t.Slug,
+			Song:      t.Song,
+			Cover:     t.Cover,
+			Rotate:    t.Rotate,
+			Likes:     t.Likes,
+			Dislikes:  t.Dislikes,
+			Listeners: t.Listeners,
+			Count:     t.Count,
 		}
 	}
 
-	return c.JSON(response)
+	return &dto.StatisticCategory{
+		Key:         cat.Key,
+		Description: cat.Description,
+		Icon:        cat.Icon,
+		Tracks:      tracks,
+		Limit:       cat.Limit,
+		Offset:      cat.Offset,
+		HasMore:     cat.HasMore,
+	}
+}
+
+// roundHours rounds to two decimals, about half a minute.
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
 }
@@ -3,7 +3,9 @@ package handler
 import (
 	"errors"
 
+	appshared "hub/internal/application/shared"
 	apptrack "hub/internal/application/track"
+	"hub/internal/domain/artist"
 	"hub/internal/domain/track"
 	"hub/internal/interfaces/http/dto"
 	"hub/internal/interfaces/http/middleware"
@@ -15,16 +17,19 @@ import (
 type TrackHandler struct {
 	upsertHandler *apptrack.UpsertTrackHandler
 	getHandler    *apptrack.GetTrackHandler
+	listHandler   *apptrack.ListTracksHandler
 }
 
 // NewTrackHandler creates a new TrackHandler.
 func NewTrackHandler(
 	upsertHandler *apptrack.UpsertTrackHandler,
 	getHandler *apptrack.GetTrackHandler,
+	listHandler *apptrack.ListTracksHandler,
 ) *TrackHandler {
 	return &TrackHandler{
 		upsertHandler: upsertHandler,
 		getHandler:    getHandler,
+		listHandler:   listHandler,
 	}
 }
 
@@ -62,14 +67,31 @@ func (h *TrackHandler) Get(c *fiber.Ctx) error {
 		return h.handleError(c, err)
 	}
 
-	return c.JSON(dto.GetTrackResponse{
-		ID:        result.ID,
-		Title:     result.Title,
-		Cover:     result.Cover,
-		Rotate:    result.Rotate,
-		Likes:     result.Likes,
-		Dislikes:  result.Dislikes,
-		Listeners: result.Listeners,
+	return c.JSON(toGetTrackResponse(result))
+}
+
+// List handles track catalogue listing and search requests.
+func (h *TrackHandler) List(c *fiber.Ctx) error {
+	result, err := h.listHandler.Handle(c.Context(), apptrack.ListTracksQuery{
+		Search: c.Query("q"),
+		Artist: c.Query("artist"),
+		Sort:   c.Query("sort"),
+		Order:  c.Query("order"),
+		Limit:  c.QueryInt("limit"),
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	items := make([]*dto.GetTrackResponse, len(result.Tracks))
+	for i, t := range result.Tracks {
+		items[i] = toGetTrackResponse(t)
+	}
+
+	return c.JSON(dto.TrackListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
 	})
 }
 
@@ -82,7 +104,34 @@ func (h *TrackHandler) handleError(c *fiber.Ctx, err error) error {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid track title"))
 	case errors.Is(err, track.ErrTrackNotFound):
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Track not found"))
+	case errors.Is(err, track.ErrInvalidSortField):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid sort field"))
+	case errors.Is(err, apptrack.ErrInvalidSortOrder):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid sort order"))
+	case errors.Is(err, artist.ErrInvalidSlug):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid artist slug"))
+	case errors.Is(err, appshared.ErrInvalidCursor):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid cursor"))
 	default:
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
 	}
 }
+
+func toGetTrackResponse(t *apptrack.TrackDTO) *dto.GetTrackResponse {
+	return &dto.GetTrackResponse{
+		ID:          t.ID,
+		Title:       t.Title,
+		Artist:      t.Artist,
+		Song:        t.Song,
+		Featuring:   t.Featuring,
+		Cover:       t.Cover,
+		Rotate:      t.Rotate,
+		Likes:       t.Likes,
+		Dislikes:    t.Dislikes,
+		Listeners:   t.Listeners,
+		Reactions:   t.Reactions,
+		TuneOutRate: t.TuneOutRate,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
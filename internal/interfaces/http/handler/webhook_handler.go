@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+
+	appwebhook "hub/internal/application/webhook"
+	"hub/internal/domain/station"
+	"hub/internal/domain/webhook"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WebhookHandler handles HTTP requests for webhook administration.
+type WebhookHandler struct {
+	createHandler     *appwebhook.CreateWebhookHandler
+	listHandler       *appwebhook.ListWebhooksHandler
+	deleteHandler     *appwebhook.DeleteWebhookHandler
+	deliveriesHandler *appwebhook.ListDeliveriesHandler
+}
+
+// NewWebhookHandler creates a new WebhookHandler.
+func NewWebhookHandler(
+	createHandler *appwebhook.CreateWebhookHandler,
+	listHandler *appwebhook.ListWebhooksHandler,
+	deleteHandler *appwebhook.DeleteWebhookHandler,
+	deliveriesHandler *appwebhook.ListDeliveriesHandler,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createHandler:     createHandler,
+		listHandler:       listHandler,
+		deleteHandler:     deleteHandler,
+		deliveriesHandler: deliveriesHandler,
+	}
+}
+
+// Create handles create webhook requests.
+func (h *WebhookHandler) Create(c *fiber.Ctx) error {
+	var req dto.CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Invalid request body"))
+	}
+
+	result, err := h.createHandler.Handle(c.Context(), appwebhook.CreateWebhookCommand{
+		URL:     req.URL,
+		Events:  req.Events,
+		Station: req.Station,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.CreateWebhookResponse{
+		WebhookResponse: toWebhookResponse(result.Webhook),
+		Secret:          result.Secret,
+	})
+}
+
+// List handles list webhooks requests.
+func (h *WebhookHandler) List(c *fiber.Ctx) error {
+	webhooks, err := h.listHandler.Handle(c.Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	response := make([]dto.WebhookResponse, len(webhooks))
+	for i, w := range webhooks {
+		response[i] = toWebhookResponse(w)
+	}
+
+	return c.JSON(response)
+}
+
+// Delete handles delete webhook requests.
+func (h *WebhookHandler) Delete(c *fiber.Ctx) error {
+	err := h.deleteHandler.Handle(c.Context(), appwebhook.DeleteWebhookCommand{ID: c.Params("id")})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Deliveries handles delivery log requests.
+func (h *WebhookHandler) Deliveries(c *fiber.Ctx) error {
+	deliveries, err := h.deliveriesHandler.Handle(c.Context(), appwebhook.ListDeliveriesQuery{
+		WebhookID: c.Params("id"),
+		Status:    c.Query("status"),
+		Limit:     c.QueryInt("limit"),
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	response := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		response[i] = dto.WebhookDeliveryResponse{
+			ID:             d.ID,
+			Event:          d.Event,
+			Station:        d.Station,
+			Payload:        d.Payload,
+			Status:         d.Status,
+			Attempts:       d.Attempts,
+			ResponseStatus: d.ResponseStatus,
+			LastError:      d.LastError,
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt,
+		}
+	}
+
+	return c.JSON(response)
+}
+
+// handleError maps domain errors to HTTP responses.
+func (h *WebhookHandler) handleError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrWebhookNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Webhook not found"))
+	case errors.Is(err, webhook.ErrInvalidURL):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("URL must be an absolute http or https URL"))
+	case errors.Is(err, webhook.ErrNoEvents):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("At least one event is required"))
+	case errors.Is(err, webhook.ErrUnknownEvent):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Unknown event"))
+	case errors.Is(err, station.ErrStationNotFound):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Unknown station"))
+	case errors.Is(err, webhook.ErrInvalidDeliveryStatus):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrBadRequest("Status must be pending, succeeded or dead"))
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+	}
+}
+
+func toWebhookResponse(w *appwebhook.WebhookDTO) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		ID:        w.ID,
+		URL:       w.URL,
+		Events:    w.Events,
+		Station:   w.Station,
+		CreatedAt: w.CreatedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package live
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"hub/internal/domain/reaction"
+
+	"github.com/gofiber/contrib/websocket"
+)
+
+const (
+	// writeWait bounds how long a single write may take.
+	writeWait = 10 * time.Second
+	// pongWait is how long a connection may stay silent before it is closed.
+	pongWait = 60 * time.Second
+	// pingPeriod must be shorter than pongWait so pongs arrive in time.
+	pingPeriod = pongWait * 9 / 10
+	// maxMessageSize caps inbound messages.
+	maxMessageSize = 4096
+	// sendBuffer is how many messages may queue for a connection before it
+	// is considered too slow and disconnected.
+	sendBuffer = 32
+)
+
+// client is a single gateway connection.
+type client struct {
+	conn       *websocket.Conn
+	stationID  int64
+	userID     reaction.UserID
+	identified bool
+
+	// trackID is the subscribed track, empty for every track. Guarded by Hub.mu.
+	trackID string
+
+	send     chan []byte
+	done     chan struct{}
+	doneOnce sync.Once
+	slow     bool // set before done is closed when the send buffer overflowed
+}
+
+func newClient(conn *websocket.Conn, stationID int64, userID reaction.UserID, identified bool) *client {
+	return &client{
+		conn:       conn,
+		stationID:  stationID,
+		userID:     userID,
+		identified: identified,
+		send:       make(chan []byte, sendBuffer),
+		done:       make(chan struct{}),
+	}
+}
+
+// enqueue queues a message without blocking.
+// It reports false when the connection is too slow to keep up.
+func (c *client) enqueue(msg OutboundMessage) bool {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return true
+	}
+
+	select {
+	case <-c.done:
+		return true
+	default:
+	}
+
+	select {
+	case c.send <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stops the write loop; slow marks a backpressure disconnect.
+func (c *client) close(slow bool) {
+	c.doneOnce.Do(func() {
+		c.slow = slow
+		close(c.done)
+	})
+}
+
+// readLoop decodes inbound messages until the connection fails.
+func (c *client) readLoop(dispatch func(*client, InboundMessage)) {
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg InboundMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			if !c.enqueue(errorMessage("", "bad_request", "Invalid message")) {
+				c.close(true)
+			}
+			continue
+		}
+		dispatch(c, msg)
+	}
+}
+
+// writeLoop writes queued messages and heartbeats until the client is closed.
+func (c *client) writeLoop() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		// Unblocks readLoop
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case data := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		case <-c.done:
+			code, text := websocket.CloseNormalClosure, ""
+			if c.slow {
+				code, text = websocket.CloseTryAgainLater, "client too slow"
+			}
+			_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(writeWait))
+			return
+		}
+	}
+}
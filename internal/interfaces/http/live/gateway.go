@@ -0,0 +1,123 @@
+package live
+
+import (
+	"context"
+	"errors"
+
+	appreaction "hub/internal/application/reaction"
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/reaction"
+	"hub/internal/domain/track"
+	"hub/internal/interfaces/http/middleware"
+	"hub/internal/logger"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Gateway serves the live WebSocket endpoint.
+// Clients subscribe to a track's reactions and react over the socket, within
+// the station of the route they connected through.
+type Gateway struct {
+	hub        *Hub
+	addHandler *appreaction.AddReactionHandler
+	logger     *logger.Logger
+}
+
+// NewGateway creates a new Gateway.
+func NewGateway(hub *Hub, addHandler *appreaction.AddReactionHandler, log *logger.Logger) *Gateway {
+	return &Gateway{
+		hub:        hub,
+		addHandler: addHandler,
+		logger:     log,
+	}
+}
+
+// Upgrade rejects requests that are not WebSocket upgrades.
+func (g *Gateway) Upgrade(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return fiber.ErrUpgradeRequired
+	}
+	return c.Next()
+}
+
+// Handler returns the WebSocket handler.
+// The connection's identity is resolved by the identity middleware before the upgrade.
+func (g *Gateway) Handler() fiber.Handler {
+	return websocket.New(g.serve)
+}
+
+func (g *Gateway) serve(conn *websocket.Conn) {
+	userID, identified := conn.Locals(middleware.UserIDKey).(reaction.UserID)
+	stationID, _ := conn.Locals(appshared.StationIDKey).(int64)
+
+	ctx, cancel := context.WithCancel(appshared.WithStationID(context.Background(), stationID))
+	defer cancel()
+
+	c := newClient(conn, appshared.StationID(ctx), userID, identified)
+
+	g.hub.register(c)
+
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		c.writeLoop()
+	}()
+
+	c.readLoop(func(c *client, msg InboundMessage) {
+		g.dispatch(ctx, c, msg)
+	})
+
+	g.hub.unregister(c)
+	// The connection is released once serve returns
+	<-written
+}
+
+func (g *Gateway) dispatch(ctx context.Context, c *client, msg InboundMessage) {
+	switch msg.Type {
+	case MessageSubscribe:
+		g.hub.subscribe(c, msg.TrackID)
+		g.hub.reply(c, ack(msg.ID))
+	case MessageReact:
+		g.hub.reply(c, g.react(ctx, c, msg))
+	default:
+		g.hub.reply(c, errorMessage(msg.ID, "bad_request", "Unknown message type"))
+	}
+}
+
+func (g *Gateway) react(ctx context.Context, c *client, msg InboundMessage) OutboundMessage {
+	if !c.identified {
+		return errorMessage(msg.ID, "unauthorized", "Identity is required")
+	}
+	if msg.TrackID == "" {
+		return errorMessage(msg.ID, "bad_request", "Track ID is required")
+	}
+
+	err := g.addHandler.Handle(ctx, appreaction.AddReactionCommand{
+		UserID:   c.userID.String(),
+		TrackID:  msg.TrackID,
+		Reaction: msg.Reaction,
+	})
+	if err != nil {
+		return g.errorFor(msg.ID, err)
+	}
+
+	return ack(msg.ID)
+}
+
+// errorFor maps domain errors to error messages, like the reaction HTTP handler.
+func (g *Gateway) errorFor(id string, err error) OutboundMessage {
+	switch {
+	case errors.Is(err, reaction.ErrInvalidReactionType):
+		return errorMessage(id, "bad_request", "Invalid reaction type")
+	case errors.Is(err, reaction.ErrReactionExists):
+		return errorMessage(id, "conflict", "User has already reacted to this track")
+	case errors.Is(err, reaction.ErrTrackNotFound):
+		return errorMessage(id, "not_found", "Track not found")
+	case errors.Is(err, track.ErrInvalidTrackID):
+		return errorMessage(id, "bad_request", "Invalid track ID format")
+	default:
+		g.logger.WithContext("live", "react").WithError(err).Error("failed to add reaction")
+		return errorMessage(id, "internal_error", "Internal server error")
+	}
+}
@@ -0,0 +1,327 @@
+package live
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/reaction"
+	"hub/internal/domain/shared"
+	"hub/internal/logger"
+)
+
+const (
+	// recentWindow is the period reaction counts are reported over,
+	// e.g. "12 people liked this just now".
+	recentWindow = time.Minute
+
+	// presenceTimeout bounds each presence update.
+	presenceTimeout = 5 * time.Second
+)
+
+// Presence shares connection counts between replicas.
+type Presence interface {
+	// Add changes this replica's connection count of a station by delta
+	// and announces the change to every replica.
+	Add(ctx context.Context, stationID int64, delta int) error
+
+	// Sync replaces this replica's connection counts, keeping them alive.
+	Sync(ctx context.Context, counts map[int64]int) error
+
+	// Total returns the connection count of a station across replicas.
+	Total(ctx context.Context, stationID int64) (int, error)
+
+	// Watch calls fn with the station of every announced change until ctx ends.
+	Watch(ctx context.Context, fn func(stationID int64)) error
+}
+
+type recentKey struct {
+	stationID int64
+	trackID   string
+	reaction  string
+}
+
+// Hub tracks gateway connections and fans reaction events out to them.
+// Presence counts connections on every replica: each change is announced
+// through presence, and every hub relays the station's total to its clients.
+type Hub struct {
+	presence     Presence
+	syncInterval time.Duration
+	logger       *logger.Logger
+
+	mu      sync.Mutex
+	clients map[*client]struct{}
+	recent  map[recentKey][]time.Time
+
+	isStarted atomic.Bool
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewHub creates a new Hub. Its connection counts are synced to presence
+// every syncInterval, well within the time presence keeps them.
+func NewHub(presence Presence, syncInterval time.Duration, log *logger.Logger) *Hub {
+	return &Hub{
+		presence:     presence,
+		syncInterval: syncInterval,
+		logger:       log,
+		clients:      make(map[*client]struct{}),
+		recent:       make(map[recentKey][]time.Time),
+	}
+}
+
+// Start relays presence changes to clients and syncs this replica's
+// connection counts in the background.
+func (h *Hub) Start() {
+	if !h.isStarted.CompareAndSwap(false, true) {
+		h.logger.Warn("Live hub already started")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+
+	h.wg.Add(2)
+	go func() {
+		defer h.wg.Done()
+		h.watchPresence(ctx)
+	}()
+	go func() {
+		defer h.wg.Done()
+		h.syncPresence(ctx)
+	}()
+}
+
+// Stop stops relaying and syncing presence.
+func (h *Hub) Stop(ctx context.Context) error {
+	if !h.isStarted.Load() {
+		return nil
+	}
+	h.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		h.isStarted.Store(false)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// HandleEvent broadcasts reaction.added events to the clients of the
+// event's station following the track.
+func (h *Hub) HandleEvent(ctx context.Context, event shared.DomainEvent) error {
+	e, ok := event.(reaction.ReactionAdded)
+	if !ok {
+		return nil
+	}
+	stationID := appshared.StationID(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	msg := OutboundMessage{
+		Type:     MessageReactionAdded,
+		TrackID:  e.TrackID().String(),
+		Reaction: e.ReactionType().String(),
+		Recent:   h.countRecent(recentKey{stationID, e.TrackID().String(), e.ReactionType().String()}, e.OccurredAt()),
+	}
+
+	h.broadcast(msg, func(c *client) bool {
+		return c.stationID == stationID && (c.trackID == "" || c.trackID == msg.TrackID)
+	})
+	return nil
+}
+
+// Connections returns the number of clients connected to this replica
+// across stations.
+func (h *Hub) Connections() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.clients)
+}
+
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.clients[c] = struct{}{}
+	h.changePresence(c.stationID, 1)
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[c]; !ok {
+		return
+	}
+	delete(h.clients, c)
+	c.close(false)
+	h.changePresence(c.stationID, -1)
+}
+
+func (h *Hub) subscribe(c *client, trackID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c.trackID = trackID
+}
+
+// reply queues a message for one client, disconnecting it if it can't keep up.
+func (h *Hub) reply(c *client, msg OutboundMessage) {
+	if !c.enqueue(msg) {
+		h.drop(c)
+	}
+}
+
+func (h *Hub) drop(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dropLocked(c)
+}
+
+// dropLocked disconnects a slow client. Callers hold h.mu.
+func (h *Hub) dropLocked(c *client) {
+	if _, ok := h.clients[c]; !ok {
+		return
+	}
+	delete(h.clients, c)
+	c.close(true)
+	h.changePresence(c.stationID, -1)
+	h.logger.WithContext("live", "broadcast").Warn("dropping slow client")
+}
+
+// broadcast sends msg to the matching clients. Callers hold h.mu.
+func (h *Hub) broadcast(msg OutboundMessage, match func(*client) bool) {
+	for c := range h.clients {
+		if match(c) && !c.enqueue(msg) {
+			h.dropLocked(c)
+		}
+	}
+}
+
+// changePresence announces a change in the connection count of a station
+// in the background, so callers may hold h.mu.
+func (h *Hub) changePresence(stationID int64, delta int) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+		defer cancel()
+
+		if err := h.presence.Add(ctx, stationID, delta); err != nil {
+			h.logger.WithContext("live", "presence").WithError(err).Warn("failed to update presence")
+		}
+	}()
+}
+
+// watchPresence relays announced presence changes until ctx ends,
+// resubscribing after failures.
+func (h *Hub) watchPresence(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := h.presence.Watch(ctx, func(stationID int64) {
+			h.broadcastPresence(ctx, stationID)
+		})
+		if err != nil && ctx.Err() == nil {
+			h.logger.WithContext("live", "presence").WithError(err).Error("failed to watch presence")
+			select {
+			case <-ctx.Done():
+			case <-time.After(h.syncInterval):
+			}
+		}
+	}
+}
+
+// syncPresence periodically syncs this replica's connection counts until
+// ctx ends, so they outlive announcements that failed and expire if the
+// replica dies.
+func (h *Hub) syncPresence(ctx context.Context) {
+	ticker := time.NewTicker(h.syncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		h.mu.Lock()
+		counts := make(map[int64]int)
+		for c := range h.clients {
+			counts[c.stationID]++
+		}
+		h.mu.Unlock()
+
+		syncCtx, cancel := context.WithTimeout(ctx, presenceTimeout)
+		if err := h.presence.Sync(syncCtx, counts); err != nil {
+			h.logger.WithContext("live", "presence").WithError(err).Warn("failed to sync presence")
+		}
+		cancel()
+	}
+}
+
+// broadcastPresence announces the connection count of a station across
+// replicas to its clients on this one.
+func (h *Hub) broadcastPresence(ctx context.Context, stationID int64) {
+	if !h.hasClients(stationID) {
+		return
+	}
+
+	totalCtx, cancel := context.WithTimeout(ctx, presenceTimeout)
+	defer cancel()
+
+	connections, err := h.presence.Total(totalCtx, stationID)
+	if err != nil {
+		h.logger.WithContext("live", "presence").WithError(err).Warn("failed to count presence")
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	msg := OutboundMessage{Type: MessagePresence, Connections: connections}
+	h.broadcast(msg, func(c *client) bool { return c.stationID == stationID })
+}
+
+// hasClients reports whether any client of a station is connected to this replica.
+func (h *Hub) hasClients(stationID int64) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for c := range h.clients {
+		if c.stationID == stationID {
+			return true
+		}
+	}
+	return false
+}
+
+// countRecent records a reaction at t and returns the count within the window.
+// Callers hold h.mu.
+func (h *Hub) countRecent(key recentKey, t time.Time) int {
+	cutoff := t.Add(-recentWindow)
+
+	times := h.recent[key]
+	i := 0
+	for i < len(times) && times[i].Before(cutoff) {
+		i++
+	}
+	times = append(times[i:], t)
+	h.recent[key] = times
+
+	// Forget other keys that went quiet so the map doesn't grow with every track
+	for k, ts := range h.recent {
+		if k != key && ts[len(ts)-1].Before(cutoff) {
+			delete(h.recent, k)
+		}
+	}
+
+	return len(times)
+}
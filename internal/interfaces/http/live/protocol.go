@@ -0,0 +1,48 @@
+package live
+
+// Message types of the live gateway protocol.
+const (
+	// Sent by clients
+	MessageSubscribe = "subscribe"
+	MessageReact     = "react"
+
+	// Sent by the server
+	MessageAck           = "ack"
+	MessageError         = "error"
+	MessageReactionAdded = "reaction.added"
+	MessagePresence      = "presence"
+)
+
+// InboundMessage is a message sent by a client.
+//
+//	{"type":"subscribe","id":"1","trackId":"..."}   // empty trackId follows every track
+//	{"type":"react","id":"2","trackId":"...","reaction":"like"}
+type InboundMessage struct {
+	Type     string `json:"type"`
+	ID       string `json:"id,omitempty"` // echoed in the ack or error
+	TrackID  string `json:"trackId,omitempty"`
+	Reaction string `json:"reaction,omitempty"`
+}
+
+// OutboundMessage is a message sent to clients.
+type OutboundMessage struct {
+	Type     string `json:"type"`
+	ID       string `json:"id,omitempty"`
+	TrackID  string `json:"trackId,omitempty"`
+	Reaction string `json:"reaction,omitempty"`
+	// Recent is how many reactions of this type the track got within the recent window
+	Recent int `json:"recent,omitempty"`
+	// Connections is the number of clients connected to the station on any
+	// replica, sent with presence messages
+	Connections int    `json:"connections,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+func ack(id string) OutboundMessage {
+	return OutboundMessage{Type: MessageAck, ID: id}
+}
+
+func errorMessage(id, code, message string) OutboundMessage {
+	return OutboundMessage{Type: MessageError, ID: id, Error: code, Message: message}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"errors"
+
+	appapikey "hub/internal/application/apikey"
+	appshared "hub/internal/application/shared"
+	"hub/internal/domain/apikey"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	APIKeyKey    = "api_key"
+)
+
+// APIKeyMiddleware authorises machine clients by API key and scope.
+type APIKeyMiddleware struct {
+	auth *appapikey.AuthenticateHandler
+}
+
+// NewAPIKeyMiddleware creates a new APIKeyMiddleware.
+func NewAPIKeyMiddleware(auth *appapikey.AuthenticateHandler) *APIKeyMiddleware {
+	return &APIKeyMiddleware{auth: auth}
+}
+
+// Require returns a handler that rejects requests whose key does not grant
+// scope or the station of the route. The authorised key is stored in context.
+func (m *APIKeyMiddleware) Require(scope apikey.Scope) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		secret := c.Get(APIKeyHeader)
+		if secret == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("X-API-Key header is required"))
+		}
+
+		// Routes outside any station, such as admin ones, only accept
+		// keys that aren't restricted to a station
+		stationID, _ := appshared.LookupStationID(c.Context())
+		key, err := m.auth.Handle(c.Context(), appapikey.AuthenticateQuery{
+			Secret:    secret,
+			Scope:     scope.String(),
+			StationID: stationID,
+		})
+		switch {
+		case err == nil:
+			c.Locals(APIKeyKey, key)
+			return c.Next()
+		case errors.Is(err, apikey.ErrInvalidAPIKey):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Invalid API key"))
+		case errors.Is(err, appapikey.ErrScopeNotGranted):
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrForbidden("API key lacks the '" + scope.String() + "' scope"))
+		case errors.Is(err, appapikey.ErrStationNotGranted):
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrForbidden("API key is restricted to another station"))
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrInternalServer)
+		}
+	}
+}
+
+// GetAPIKey retrieves the authorised API key from context.
+func GetAPIKey(c *fiber.Ctx) (*appapikey.APIKeyDTO, bool) {
+	key, ok := c.Locals(APIKeyKey).(*appapikey.APIKeyDTO)
+	return key, ok
+}
@@ -1,13 +1,15 @@
 package middleware
 
 import (
+	appshared "hub/internal/application/shared"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
 
 const (
 	CorrelationIDHeader = "X-Correlation-ID"
-	CorrelationIDKey    = "correlation_id"
+	CorrelationIDKey    = appshared.CorrelationIDKey
 )
 
 // CorrelationIDMiddleware adds correlation ID to requests.
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"strings"
+
+	"hub/internal/application/identity"
+	"hub/internal/domain/reaction"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	UserIDHeader = "X-User-ID"
+	UserIDKey    = "user_id"
+
+	// tokenQuery carries the identity token for clients that can't set
+	// headers, such as browser WebSocket connections.
+	tokenQuery = "token"
+)
+
+// IdentityMiddleware resolves the listener identity of a request from a
+// signed bearer token. The unsigned X-User-ID header is only honoured when
+// allowHeader is set, for clients that have not migrated to tokens yet.
+type IdentityMiddleware struct {
+	service     identity.Service
+	allowHeader bool
+}
+
+// NewIdentityMiddleware creates a new IdentityMiddleware.
+func NewIdentityMiddleware(service identity.Service, allowHeader bool) *IdentityMiddleware {
+	return &IdentityMiddleware{service: service, allowHeader: allowHeader}
+}
+
+// Require rejects requests without a trusted identity and stores the user ID in context.
+func (m *IdentityMiddleware) Require(c *fiber.Ctx) error {
+	if token, ok := bearerToken(c); ok {
+		userID, err := m.service.Verify(c.Context(), token)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Invalid identity token"))
+		}
+		c.Locals(UserIDKey, userID)
+		return c.Next()
+	}
+
+	if m.allowHeader {
+		if userID, err := reaction.NewUserID(c.Get(UserIDHeader)); err == nil {
+			c.Locals(UserIDKey, userID)
+			return c.Next()
+		}
+	}
+
+	return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Identity token is required, see POST /identity"))
+}
+
+// Identify stores the user ID in context when the request carries a trusted
+// identity and lets anonymous requests through. The token may also be passed
+// in the token query parameter. Invalid tokens are still rejected.
+func (m *IdentityMiddleware) Identify(c *fiber.Ctx) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		token = c.Query(tokenQuery)
+	}
+
+	if token != "" {
+		userID, err := m.service.Verify(c.Context(), token)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrUnauthorized("Invalid identity token"))
+		}
+		c.Locals(UserIDKey, userID)
+		return c.Next()
+	}
+
+	if m.allowHeader {
+		if userID, err := reaction.NewUserID(c.Get(UserIDHeader)); err == nil {
+			c.Locals(UserIDKey, userID)
+		}
+	}
+
+	return c.Next()
+}
+
+// GetUserID retrieves the trusted user ID from context.
+func GetUserID(c *fiber.Ctx) (reaction.UserID, bool) {
+	userID, ok := c.Locals(UserIDKey).(reaction.UserID)
+	return userID, ok
+}
+
+// bearerToken extracts the token from the Authorization header.
+func bearerToken(c *fiber.Ctx) (string, bool) {
+	scheme, token, ok := strings.Cut(c.Get(fiber.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
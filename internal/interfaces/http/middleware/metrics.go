@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
@@ -9,17 +10,36 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+// unmatchedRoute labels requests that matched no route.
+const unmatchedRoute = "unmatched"
+
 // MetricsMiddleware records HTTP request metrics.
+// Requests are labelled with the matched route template, e.g. /tracks/:id,
+// so path parameters don't create a label per value.
 func MetricsMiddleware(m *metrics.Metrics) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		start := time.Now()
+		own := c.Route()
 
 		err := c.Next()
 
 		duration := time.Since(start)
-		status := strconv.Itoa(c.Response().StatusCode())
 
-		m.RecordHTTPRequest(c.Method(), c.Path(), status, duration)
+		// Errors are turned into responses by the app error handler after this returns
+		code := c.Response().StatusCode()
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			code = fiberErr.Code
+		} else if err != nil {
+			code = fiber.StatusInternalServerError
+		}
+
+		path := c.Route().Path
+		if c.Route() == own {
+			path = unmatchedRoute
+		}
+
+		m.RecordHTTPRequest(c.Method(), path, strconv.Itoa(code), duration)
 
 		return err
 	}
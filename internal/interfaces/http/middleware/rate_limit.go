@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimitMiddleware limits how often a client IP may call a route, counting
+// requests in fixed windows in Redis so the limit holds across replicas.
+type RateLimitMiddleware struct {
+	client *redis.Client
+	name   string
+	limit  int
+	window time.Duration
+}
+
+// NewRateLimitMiddleware creates a RateLimitMiddleware allowing limit
+// requests per window under name. A limit of zero disables it.
+func NewRateLimitMiddleware(client *redis.Client, name string, limit int, window time.Duration) *RateLimitMiddleware {
+	return &RateLimitMiddleware{client: client, name: name, limit: limit, window: window}
+}
+
+// Limit rejects requests over the limit with 429 Too Many Requests. Requests
+// are let through when Redis is unavailable.
+func (m *RateLimitMiddleware) Limit(c *fiber.Ctx) error {
+	if m.limit <= 0 {
+		return c.Next()
+	}
+
+	key := "hub:ratelimit:" + m.name + ":" + c.IP()
+
+	// The first request of a window starts its expiry
+	pipe := m.client.TxPipeline()
+	count := pipe.Incr(c.Context(), key)
+	pipe.ExpireNX(c.Context(), key, m.window)
+	ttl := pipe.PTTL(c.Context(), key)
+	if _, err := pipe.Exec(c.Context()); err != nil {
+		return c.Next()
+	}
+
+	if count.Val() > int64(m.limit) {
+		retryAfter := max(int(ttl.Val().Round(time.Second)/time.Second), 1)
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+		return c.Status(fiber.StatusTooManyRequests).JSON(dto.ErrTooManyRequests("Too many requests, retry later"))
+	}
+
+	return c.Next()
+}
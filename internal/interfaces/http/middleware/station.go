@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	appshared "hub/internal/application/shared"
+	appstation "hub/internal/application/station"
+	"hub/internal/domain/station"
+	"hub/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// StationSlugParam is the route parameter naming the station. It differs
+// from the :slug of artist routes, which are nested under station routes.
+const StationSlugParam = "station"
+
+// StationMiddleware scopes requests to a station.
+type StationMiddleware struct {
+	stations *appstation.Registry
+}
+
+// NewStationMiddleware creates a new StationMiddleware.
+func NewStationMiddleware(stations *appstation.Registry) *StationMiddleware {
+	return &StationMiddleware{stations: stations}
+}
+
+// Resolve stores the station named by the route in context, rejecting
+// unknown stations.
+func (m *StationMiddleware) Resolve(c *fiber.Ctx) error {
+	s, err := m.stations.Get(c.Params(StationSlugParam))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrNotFound("Station not found"))
+	}
+
+	c.Locals(appshared.StationIDKey, s.ID())
+	return c.Next()
+}
+
+// Default scopes requests outside the station routes to the default station.
+func (m *StationMiddleware) Default(c *fiber.Ctx) error {
+	c.Locals(appshared.StationIDKey, station.DefaultID)
+	return c.Next()
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"hub/internal/infrastructure/metrics"
+	"hub/internal/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// AdminServer serves operational endpoints on a port separate from the public API.
+type AdminServer struct {
+	app    *fiber.App
+	logger *logger.Logger
+}
+
+// NewAdminServer creates a new AdminServer exposing /metrics.
+func NewAdminServer(m *metrics.Metrics, logger *logger.Logger) *AdminServer {
+	app := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+	})
+	app.Get("/metrics", adaptor.HTTPHandler(m.Handler()))
+
+	return &AdminServer{
+		app:    app,
+		logger: logger,
+	}
+}
+
+// Listen starts the admin server.
+func (s *AdminServer) Listen(port int) error {
+	addr := fmt.Sprintf(":%d", port)
+	s.logger.Info(fmt.Sprintf("Starting admin server on %s", addr))
+	return s.app.Listen(addr)
+}
+
+// Shutdown gracefully shuts down the admin server.
+func (s *AdminServer) Shutdown(ctx context.Context) error {
+	return s.app.ShutdownWithContext(ctx)
+}
@@ -17,7 +17,7 @@ func NewFiberApp() *fiber.App {
 	app.Use(cors.New(cors.Config{
 		AllowOrigins: "*",
 		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders: "Origin,Content-Type,Accept,Authorization,X-User-ID",
+		AllowHeaders: "Origin,Content-Type,Accept,Authorization,X-User-ID,Last-Event-ID",
 	}))
 
 	return app
@@ -1,7 +1,10 @@
 package server
 
 import (
+	"hub/internal/domain/apikey"
+	"hub/internal/infrastructure/metrics"
 	"hub/internal/interfaces/http/handler"
+	"hub/internal/interfaces/http/live"
 	"hub/internal/interfaces/http/middleware"
 
 	"github.com/gofiber/fiber/v2"
@@ -13,7 +16,20 @@ type Router struct {
 	reactionHandler   *handler.ReactionHandler
 	radioHandler      *handler.RadioHandler
 	statisticsHandler *handler.StatisticsHandler
+	historyHandler    *handler.HistoryHandler
+	nowPlayingHandler *handler.NowPlayingHandler
+	artistHandler     *handler.ArtistHandler
+	identityHandler   *handler.IdentityHandler
 	healthHandler     *handler.HealthHandler
+	webhookHandler    *handler.WebhookHandler
+	jobHandler        *handler.JobHandler
+	stationHandler    *handler.StationHandler
+	liveGateway       *live.Gateway
+	identity          *middleware.IdentityMiddleware
+	identityLimit     *middleware.RateLimitMiddleware
+	apiKeys           *middleware.APIKeyMiddleware
+	stations          *middleware.StationMiddleware
+	metrics           *metrics.Metrics
 }
 
 // NewRouter creates a new Router.
@@ -22,35 +38,102 @@ func NewRouter(
 	reactionHandler *handler.ReactionHandler,
 	radioHandler *handler.RadioHandler,
 	statisticsHandler *handler.StatisticsHandler,
+	historyHandler *handler.HistoryHandler,
+	nowPlayingHandler *handler.NowPlayingHandler,
+	artistHandler *handler.ArtistHandler,
+	identityHandler *handler.IdentityHandler,
 	healthHandler *handler.HealthHandler,
+	webhookHandler *handler.WebhookHandler,
+	jobHandler *handler.JobHandler,
+	stationHandler *handler.StationHandler,
+	liveGateway *live.Gateway,
+	identity *middleware.IdentityMiddleware,
+	identityLimit *middleware.RateLimitMiddleware,
+	apiKeys *middleware.APIKeyMiddleware,
+	stations *middleware.StationMiddleware,
+	metrics *metrics.Metrics,
 ) *Router {
 	return &Router{
 		trackHandler:      trackHandler,
 		reactionHandler:   reactionHandler,
 		radioHandler:      radioHandler,
 		statisticsHandler: statisticsHandler,
+		historyHandler:    historyHandler,
+		nowPlayingHandler: nowPlayingHandler,
+		artistHandler:     artistHandler,
+		identityHandler:   identityHandler,
 		healthHandler:     healthHandler,
+		webhookHandler:    webhookHandler,
+		jobHandler:        jobHandler,
+		stationHandler:    stationHandler,
+		liveGateway:       liveGateway,
+		identity:          identity,
+		identityLimit:     identityLimit,
+		apiKeys:           apiKeys,
+		stations:          stations,
+		metrics:           metrics,
 	}
 }
 
 // Setup configures all routes on the Fiber app.
 func (r *Router) Setup(app *fiber.App) {
+	app.Use(middleware.MetricsMiddleware(r.metrics))
+
 	// Health check
 	app.Get("/health", r.healthHandler.Health)
 
+	// Identity routes
+	app.Post("/identity", r.identityLimit.Limit, r.identityHandler.Issue)
+
+	// Admin routes span every station
+	admin := app.Group("/admin", r.apiKeys.Require(apikey.ScopeAdmin))
+	admin.Get("/webhooks", r.webhookHandler.List)
+	admin.Post("/webhooks", r.webhookHandler.Create)
+	admin.Delete("/webhooks/:id", r.webhookHandler.Delete)
+	admin.Get("/webhooks/:id/deliveries", r.webhookHandler.Deliveries)
+	admin.Get("/jobs", r.jobHandler.List)
+	admin.Post("/jobs/:name/run", r.jobHandler.Run)
+
+	// Station routes: the unprefixed routes serve the default station. Their
+	// middleware applies to every later route, so they come last.
+	app.Get("/stations", r.stationHandler.List)
+	r.setupStation(app.Group("/stations/:"+middleware.StationSlugParam, r.stations.Resolve))
+	r.setupStation(app.Group("", r.stations.Default))
+}
+
+// setupStation configures the routes scoped to a station.
+func (r *Router) setupStation(app fiber.Router) {
 	// Track routes
+	app.Get("/tracks", r.trackHandler.List)
 	app.Get("/tracks/:id", r.trackHandler.Get)
-	app.Post("/tracks", middleware.ValidateTrackRequest(), r.trackHandler.Upsert)
+	app.Post("/tracks", r.apiKeys.Require(apikey.ScopeTracksWrite), middleware.ValidateTrackRequest(), r.trackHandler.Upsert)
+
+	// Artist routes
+	app.Get("/artists", r.artistHandler.List)
+	app.Get("/artists/:slug", r.artistHandler.Get)
 
 	// Reaction routes
-	app.Post("/tracks/:trackId/like", r.reactionHandler.Like)
-	app.Post("/tracks/:trackId/dislike", r.reactionHandler.Dislike)
-	app.Get("/tracks/:trackId/reaction", r.reactionHandler.Check)
+	app.Get("/reaction-types", r.reactionHandler.ListTypes)
+	app.Post("/tracks/:trackId/reactions/:type", r.identity.Require, r.reactionHandler.React)
+	app.Post("/tracks/:trackId/like", r.identity.Require, r.reactionHandler.Like)
+	app.Post("/tracks/:trackId/dislike", r.identity.Require, r.reactionHandler.Dislike)
+	app.Get("/tracks/:trackId/reaction", r.identity.Require, r.reactionHandler.Check)
+	app.Put("/tracks/:trackId/reaction", r.identity.Require, r.reactionHandler.Change)
+	app.Delete("/tracks/:trackId/reaction", r.identity.Require, r.reactionHandler.Remove)
 
 	// Radio routes
 	app.Get("/radio/info", r.radioHandler.GetInfo)
 	app.Get("/radio/listeners", r.radioHandler.GetListen)
+	app.Get("/radio/mounts", r.radioHandler.GetMounts)
+	app.Get("/radio/mounts/:mount/info", r.radioHandler.GetMountInfo)
+	app.Get("/radio/history", r.historyHandler.GetHistory)
+	app.Get("/radio/now-playing/stream", r.nowPlayingHandler.Stream)
+
+	// Live gateway: reactions and presence over WebSocket
+	app.Get("/ws", r.identity.Identify, r.liveGateway.Upgrade, r.liveGateway.Handler())
 
 	// Statistics routes
 	app.Get("/radio/statistics", r.statisticsHandler.GetStatistics)
+	app.Get("/radio/statistics/:key", r.statisticsHandler.GetCategory)
+	app.Get("/radio/listening", r.statisticsHandler.GetListening)
 }
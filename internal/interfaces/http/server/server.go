@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"hub/internal/infrastructure/metrics"
 	"hub/internal/logger"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 )
 
 // Server represents thserver.
@@ -31,6 +33,11 @@ func NewServer(
 	}
 }
 
+// MountMetrics exposes the Prometheus metrics on /metrics of the main server.
+func (s *Server) MountMetrics(m *metrics.Metrics) {
+	s.app.Get("/metrics", adaptor.HTTPHandler(m.Handler()))
+}
+
 // Start starts the HTTP server.
 func (s *Server) Start(port int) error {
 	addr := fmt.Sprintf(":%d", port)
@@ -6,23 +6,50 @@ package wire
 //go:generate go run github.com/google/wire/cmd/wire
 
 import (
+	"context"
+	"fmt"
+
+	appapikey "hub/internal/application/apikey"
+	appartist "hub/internal/application/artist"
+	appidentity "hub/internal/application/identity"
 	"hub/internal/application/listener"
+	"hub/internal/application/nowplaying"
+	appplay "hub/internal/application/play"
 	"hub/internal/application/radio"
 	appreaction "hub/internal/application/reaction"
 	appshared "hub/internal/application/shared"
+	appstation "hub/internal/application/station"
 	"hub/internal/application/statistics"
 	apptrack "hub/internal/application/track"
+	appwebhook "hub/internal/application/webhook"
 	"hub/internal/config"
 	"hub/internal/database"
+	domainapikey "hub/internal/domain/apikey"
+	domainartist "hub/internal/domain/artist"
+	domainlistener "hub/internal/domain/listener"
+	domainplay "hub/internal/domain/play"
 	domainreaction "hub/internal/domain/reaction"
+	domainstation "hub/internal/domain/station"
 	"hub/internal/domain/track"
+	domainwebhook "hub/internal/domain/webhook"
+	"hub/internal/infrastructure/breaker"
 	"hub/internal/infrastructure/cache"
 	"hub/internal/infrastructure/events"
 	"hub/internal/infrastructure/icecast"
+	"hub/internal/infrastructure/identity"
+	"hub/internal/infrastructure/leader"
 	"hub/internal/infrastructure/metrics"
+	"hub/internal/infrastructure/outbox"
 	"hub/internal/infrastructure/persistence/postgres"
+	"hub/internal/infrastructure/presence"
 	"hub/internal/infrastructure/scheduler"
+	"hub/internal/infrastructure/scheduler/job"
+	"hub/internal/infrastructure/shoutcast"
+	"hub/internal/infrastructure/streaming"
+	"hub/internal/infrastructure/webhook"
 	"hub/internal/interfaces/http/handler"
+	"hub/internal/interfaces/http/live"
+	"hub/internal/interfaces/http/middleware"
 	"hub/internal/interfaces/http/server"
 	"hub/internal/logger"
 
@@ -33,11 +60,36 @@ import (
 
 // Application holds all dependencies for the serve command
 type Application struct {
-	Config    config.Config
-	Logger    *logger.Logger
-	Database  database.Database
-	Server    *server.Server
-	Scheduler scheduler.Scheduler
+	Config      config.Config
+	Logger      *logger.Logger
+	Database    database.Database
+	Server      *server.Server
+	AdminServer *server.AdminServer
+	Scheduler   scheduler.Scheduler
+	OutboxRelay *outbox.Relay
+	Webhooks    *webhook.Worker
+	EventBus    *events.StreamBus
+	Live        *live.Hub
+}
+
+// TracksApp holds dependencies for track maintenance commands
+type TracksApp struct {
+	Config        config.Config
+	Logger        *logger.Logger
+	Database      database.Database
+	Stations      *appstation.Registry
+	BackfillNames *apptrack.BackfillNamesHandler
+}
+
+// APIKeyApp holds dependencies for API key commands
+type APIKeyApp struct {
+	Config   config.Config
+	Logger   *logger.Logger
+	Database database.Database
+	Stations *appstation.Registry
+	Create   *appapikey.CreateAPIKeyHandler
+	List     *appapikey.ListAPIKeysHandler
+	Revoke   *appapikey.RevokeAPIKeyHandler
 }
 
 // MigrateApp holds dependencies for migrate commands
@@ -69,12 +121,82 @@ func ProvidePool(db database.Database) *pgxpool.Pool {
 	return db.Pool()
 }
 
-func ProvideEventPublisher() appshared.EventPublisher {
-	return events.NewInMemoryPublisher()
-}
-
-func ProvideCache(cfg config.Config, log *logger.Logger) (cache.Cache, error) {
-	return cache.NewCache(cfg, log)
+func ProvideEventPublisher(store outbox.Store) appshared.EventPublisher {
+	return outbox.NewPublisher(store)
+}
+
+// workerHandlers builds one publisher per handler that must run once per
+// event across all replicas, so each is retried on its own.
+func workerHandlers(rp *appplay.RecordPlayHandler, wh *appwebhook.EnqueueDeliveriesHandler) map[string]*events.InMemoryPublisher {
+	plays := events.NewInMemoryPublisher()
+	plays.Register(track.EventTrackCreated, rp.HandleEvent)
+	plays.Register(track.EventTrackRotated, rp.HandleEvent)
+
+	webhooks := events.NewInMemoryPublisher()
+	for _, name := range domainwebhook.Events {
+		webhooks.Register(name, wh.HandleEvent)
+	}
+
+	return map[string]*events.InMemoryPublisher{"plays": plays, "webhooks": webhooks}
+}
+
+// ProvideBroadcastHandlers registers the handlers that must run on every
+// replica, because they feed the clients connected to it.
+func ProvideBroadcastHandlers(np *nowplaying.Broadcaster, lh *live.Hub) *events.InMemoryPublisher {
+	pub := events.NewInMemoryPublisher()
+	pub.Register(track.EventTrackCreated, np.HandleEvent)
+	pub.Register(track.EventTrackRotated, np.HandleEvent)
+	pub.Register(domainreaction.EventReactionAdded, np.HandleEvent)
+	pub.Register(domainreaction.EventReactionChanged, np.HandleEvent)
+	pub.Register(domainreaction.EventReactionRemoved, np.HandleEvent)
+	pub.Register(domainreaction.EventReactionAdded, lh.HandleEvent)
+	pub.Register(domainlistener.EventListenersCounted, np.HandleEvent)
+	return pub
+}
+
+func ProvideEventBus(cfg config.Config, client *redis.Client, rp *appplay.RecordPlayHandler, wh *appwebhook.EnqueueDeliveriesHandler, broadcast *events.InMemoryPublisher, log *logger.Logger) *events.StreamBus {
+	_, stream, group, start := cfg.EventBus()
+	_, prefix := cfg.RedisConnection()
+
+	return events.NewStreamBus(client, prefix+stream, group, start, workerHandlers(rp, wh), broadcast, log)
+}
+
+// ProvideEventRouting routes relayed events. With Redis the stream is the
+// only subscriber and tracks each worker through its own consumer group;
+// in memory each worker is a subscriber of its own and the broadcast
+// handlers are kept out of retries.
+func ProvideEventRouting(cfg config.Config, bus *events.StreamBus, rp *appplay.RecordPlayHandler, wh *appwebhook.EnqueueDeliveriesHandler, broadcast *events.InMemoryPublisher) (outbox.Routing, error) {
+	driver, _, _, _ := cfg.EventBus()
+	switch driver {
+	case config.EventBusRedis:
+		return outbox.Routing{Subscribers: map[string]outbox.Dispatcher{"bus": bus}}, nil
+	case config.EventBusMemory:
+		subscribers := make(map[string]outbox.Dispatcher)
+		for name, pub := range workerHandlers(rp, wh) {
+			subscribers[name] = pub
+		}
+		return outbox.Routing{Subscribers: subscribers, Broadcast: broadcast}, nil
+	default:
+		return outbox.Routing{}, fmt.Errorf("unknown event bus %q", driver)
+	}
+}
+
+// ProvideBroadcastPublisher publishes to the broadcast handlers of every
+// replica: through the stream with Redis, directly in memory.
+func ProvideBroadcastPublisher(cfg config.Config, bus *events.StreamBus, broadcast *events.InMemoryPublisher) (appshared.BroadcastPublisher, error) {
+	driver, _, _, _ := cfg.EventBus()
+	switch driver {
+	case config.EventBusRedis:
+		return bus, nil
+	case config.EventBusMemory:
+		return broadcast, nil
+	default:
+		return nil, fmt.Errorf("unknown event bus %q", driver)
+	}
+}
+
+func ProvideCache(cfg config.Config, log *logger.Logger, m *metrics.Metrics) (cache.Cache, error) {
+	return cache.NewCache(cfg, log, m)
 }
 
 func ProvideRedisClient(c cache.Cache) *redis.Client {
@@ -85,28 +207,82 @@ func ProvideMetrics() *metrics.Metrics {
 	return metrics.NewMetrics()
 }
 
-func ProvideUnitOfWork(pool *pgxpool.Pool) *postgres.UnitOfWork {
+func ProvideUnitOfWork(pool *pgxpool.Pool) appshared.UnitOfWork {
 	return postgres.NewUnitOfWork(pool)
 }
 
-func ProvideTrackRepository(pool *pgxpool.Pool) *postgres.TrackRepository {
-	return postgres.NewTrackRepository(pool)
+func ProvideOutboxStore(pool *pgxpool.Pool, m *metrics.Metrics) outbox.Store {
+	return postgres.NewOutboxRepository(pool, m)
+}
+
+func ProvideOutboxRelay(store outbox.Store, routing outbox.Routing, cfg config.Config, log *logger.Logger) *outbox.Relay {
+	interval, batchSize, maxAttempts := cfg.Outbox()
+	return outbox.NewRelay(store, routing, interval, batchSize, maxAttempts, log)
+}
+
+func ProvideStationRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainstation.Repository {
+	return postgres.NewStationRepository(pool, m)
+}
+
+func ProvideStationRegistry(cfg config.Config, repo domainstation.Repository) (*appstation.Registry, error) {
+	slugs := cfg.Stations()
+	defs := make([]appstation.Definition, len(slugs))
+	for i, slug := range slugs {
+		defs[i] = appstation.Definition{Slug: slug, Name: cfg.StationName(slug)}
+	}
+	return appstation.NewRegistry(context.Background(), repo, defs)
+}
+
+func ProvideTrackRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.TrackRepository {
+	return postgres.NewTrackRepository(pool, m)
 }
 
 func ProvideTrackDomainRepository(repo *postgres.TrackRepository) track.Repository {
 	return repo
 }
 
-func ProvideReactionRepository(pool *pgxpool.Pool) domainreaction.Repository {
-	return postgres.NewReactionRepository(pool)
+func ProvideReactionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ReactionRepository {
+	return postgres.NewReactionRepository(pool, m)
+}
+
+func ProvideReactionDomainRepository(repo *postgres.ReactionRepository) domainreaction.Repository {
+	return repo
+}
+
+func ProvideReactionTypeRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainreaction.TypeRepository {
+	return postgres.NewReactionTypeRepository(pool, m)
+}
+
+func ProvideListenerRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ListenerRepository {
+	return postgres.NewListenerRepository(pool, m)
+}
+
+func ProvideStatisticsRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.StatisticsRepository {
+	return postgres.NewStatisticsRepository(pool, m)
+}
+
+func ProvidePlayRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.PlayRepository {
+	return postgres.NewPlayRepository(pool, m)
+}
+
+func ProvidePlayDomainRepository(repo *postgres.PlayRepository) domainplay.Repository {
+	return repo
+}
+
+func ProvideArtistRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainartist.Repository {
+	return postgres.NewArtistRepository(pool, m)
+}
+
+func ProvideAPIKeyRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainapikey.Repository {
+	return postgres.NewAPIKeyRepository(pool, m)
 }
 
-func ProvideListenerRepository(pool *pgxpool.Pool) *postgres.ListenerRepository {
-	return postgres.NewListenerRepository(pool)
+func ProvideWebhookRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainwebhook.Repository {
+	return postgres.NewWebhookRepository(pool, m)
 }
 
-func ProvideStatisticsRepository(pool *pgxpool.Pool) *postgres.StatisticsRepository {
-	return postgres.NewStatisticsRepository(pool)
+func ProvideWebhookDeliveryRepository(pool *pgxpool.Pool, m *metrics.Metrics) domainwebhook.DeliveryRepository {
+	return postgres.NewWebhookDeliveryRepository(pool, m)
 }
 
 func ProvideListenerAdapter(repo *postgres.ListenerRepository) *postgres.ListenerAdapter {
@@ -117,44 +293,217 @@ func ProvideTrackListenerAdapter(repo *postgres.TrackRepository) *postgres.Track
 	return postgres.NewTrackListenerAdapter(repo)
 }
 
-func ProvideUpsertTrackHandler(repo track.Repository, pub appshared.EventPublisher) *apptrack.UpsertTrackHandler {
-	return apptrack.NewUpsertTrackHandler(repo, pub)
+func ProvideTitleParser(cfg config.Config) *track.TitleParser {
+	return track.NewTitleParser(cfg.TitleSeparators())
+}
+
+func ProvideUpsertTrackHandler(repo track.Repository, parser *track.TitleParser, uow appshared.UnitOfWork, pub appshared.EventPublisher) *apptrack.UpsertTrackHandler {
+	return apptrack.NewUpsertTrackHandler(repo, parser, uow, pub)
+}
+
+func ProvideGetTrackHandler(repo track.Repository, rr *postgres.ReactionRepository, pr *postgres.PlayRepository) *apptrack.GetTrackHandler {
+	return apptrack.NewGetTrackHandler(repo, rr, pr)
+}
+
+func ProvideListTracksHandler(repo track.Repository) *apptrack.ListTracksHandler {
+	return apptrack.NewListTracksHandler(repo)
+}
+
+func ProvideBackfillNamesHandler(repo track.Repository, parser *track.TitleParser) *apptrack.BackfillNamesHandler {
+	return apptrack.NewBackfillNamesHandler(repo, parser)
+}
+
+func ProvideListArtistsHandler(repo domainartist.Repository) *appartist.ListArtistsHandler {
+	return appartist.NewListArtistsHandler(repo)
 }
 
-func ProvideGetTrackHandler(repo track.Repository) *apptrack.GetTrackHandler {
-	return apptrack.NewGetTrackHandler(repo)
+func ProvideGetArtistHandler(repo domainartist.Repository) *appartist.GetArtistHandler {
+	return appartist.NewGetArtistHandler(repo)
 }
 
-func ProvideAddReactionHandler(rr domainreaction.Repository, tr track.Repository, pub appshared.EventPublisher) *appreaction.AddReactionHandler {
-	return appreaction.NewAddReactionHandler(rr, tr, pub)
+func ProvideAddReactionHandler(rr domainreaction.Repository, rtr domainreaction.TypeRepository, tr track.Repository, uow appshared.UnitOfWork, pub appshared.EventPublisher) *appreaction.AddReactionHandler {
+	return appreaction.NewAddReactionHandler(rr, rtr, tr, uow, pub)
 }
 
 func ProvideCheckReactionHandler(rr domainreaction.Repository) *appreaction.CheckReactionHandler {
 	return appreaction.NewCheckReactionHandler(rr)
 }
 
-func ProvideIcecastClient(cfg config.Config) (icecast.Client, error) {
-	return icecast.NewClient(cfg)
+func ProvideChangeReactionHandler(rr domainreaction.Repository, rtr domainreaction.TypeRepository, uow appshared.UnitOfWork, pub appshared.EventPublisher) *appreaction.ChangeReactionHandler {
+	return appreaction.NewChangeReactionHandler(rr, rtr, uow, pub)
+}
+
+func ProvideRemoveReactionHandler(rr domainreaction.Repository, uow appshared.UnitOfWork, pub appshared.EventPublisher) *appreaction.RemoveReactionHandler {
+	return appreaction.NewRemoveReactionHandler(rr, uow, pub)
+}
+
+func ProvideListReactionTypesHandler(rtr domainreaction.TypeRepository) *appreaction.ListReactionTypesHandler {
+	return appreaction.NewListReactionTypesHandler(rtr)
+}
+
+func ProvideCreateAPIKeyHandler(repo domainapikey.Repository, reg *appstation.Registry) *appapikey.CreateAPIKeyHandler {
+	return appapikey.NewCreateAPIKeyHandler(repo, reg)
+}
+
+func ProvideListAPIKeysHandler(repo domainapikey.Repository) *appapikey.ListAPIKeysHandler {
+	return appapikey.NewListAPIKeysHandler(repo)
+}
+
+func ProvideRevokeAPIKeyHandler(repo domainapikey.Repository) *appapikey.RevokeAPIKeyHandler {
+	return appapikey.NewRevokeAPIKeyHandler(repo)
+}
+
+func ProvideAuthenticateHandler(repo domainapikey.Repository, log *logger.Logger) *appapikey.AuthenticateHandler {
+	return appapikey.NewAuthenticateHandler(repo, log)
+}
+
+func ProvideCreateWebhookHandler(repo domainwebhook.Repository, reg *appstation.Registry) *appwebhook.CreateWebhookHandler {
+	return appwebhook.NewCreateWebhookHandler(repo, reg)
+}
+
+func ProvideListWebhooksHandler(repo domainwebhook.Repository, reg *appstation.Registry) *appwebhook.ListWebhooksHandler {
+	return appwebhook.NewListWebhooksHandler(repo, reg)
+}
+
+func ProvideDeleteWebhookHandler(repo domainwebhook.Repository) *appwebhook.DeleteWebhookHandler {
+	return appwebhook.NewDeleteWebhookHandler(repo)
+}
+
+func ProvideListDeliveriesHandler(repo domainwebhook.Repository, dr domainwebhook.DeliveryRepository) *appwebhook.ListDeliveriesHandler {
+	return appwebhook.NewListDeliveriesHandler(repo, dr)
+}
+
+func ProvideEnqueueDeliveriesHandler(repo domainwebhook.Repository, dr domainwebhook.DeliveryRepository, reg *appstation.Registry) *appwebhook.EnqueueDeliveriesHandler {
+	return appwebhook.NewEnqueueDeliveriesHandler(repo, dr, reg)
+}
+
+func ProvideWebhookWorker(repo domainwebhook.Repository, dr domainwebhook.DeliveryRepository, cfg config.Config, log *logger.Logger) *webhook.Worker {
+	interval, timeout, maxAttempts := cfg.Webhooks()
+	return webhook.NewWorker(repo, dr, interval, timeout, maxAttempts, log)
+}
+
+func ProvideRecordPlayHandler(repo domainplay.Repository, uow appshared.UnitOfWork, svc radio.Service, sr *postgres.ListenerSessionRepository, cfg config.Config, log *logger.Logger) *appplay.RecordPlayHandler {
+	return appplay.NewRecordPlayHandler(repo, uow, svc, sr, cfg.TuneOutWindow(), log)
+}
+
+func ProvideGetHistoryHandler(repo *postgres.PlayRepository) *appplay.GetHistoryHandler {
+	return appplay.NewGetHistoryHandler(repo)
+}
+
+// ProvideNowPlayingBroadcaster creates the broadcaster and seeds the track on
+// air of every station; a station that can't be seeded starts empty.
+func ProvideNowPlayingBroadcaster(gh *apptrack.GetTrackHandler, repo domainplay.Repository, reg *appstation.Registry, log *logger.Logger) *nowplaying.Broadcaster {
+	np := nowplaying.NewBroadcaster(gh, repo, log)
+	for _, s := range reg.All() {
+		ctx := appshared.WithStationID(context.Background(), s.ID())
+		if err := np.Seed(ctx); err != nil {
+			log.WithContext("nowplaying", "seed").WithError(err).WithField("station", s.Slug()).Warn("failed to seed now playing")
+		}
+	}
+	return np
+}
+
+// ProvideStreamingClients creates the streaming server client of every
+// station with the adapter of its backend, each with its own circuit breaker.
+func ProvideStreamingClients(cfg config.Config, reg *appstation.Registry, m *metrics.Metrics) (streaming.Clients, error) {
+	timeout, retries, backoff := cfg.StreamingHTTP()
+	failures, cooldown := cfg.StreamingBreaker()
+
+	stations := reg.All()
+	clients := make(streaming.Clients, len(stations))
+	for _, s := range stations {
+		slug := s.Slug()
+		host, user, password, mounts := cfg.StreamingConnection(slug)
+
+		br := breaker.New(slug, failures, cooldown, func(state breaker.State) {
+			m.SetCircuitBreakerState(slug, int(state))
+		})
+		m.SetCircuitBreakerState(slug, int(br.State()))
+		http := streaming.NewHTTPClient(timeout, retries, backoff, br)
+
+		var (
+			c   streaming.Client
+			err error
+		)
+		switch backend := cfg.StreamingBackend(slug); backend {
+		case config.IcecastAdmin:
+			c, err = icecast.NewClient(host, user, password, mounts, http)
+		case config.IcecastStatus:
+			c, err = icecast.NewStatusClient(host, mounts, http)
+		case config.ShoutcastV2:
+			c, err = shoutcast.NewClient(host, user, password, mounts, http)
+		default:
+			err = fmt.Errorf("unknown streaming backend %q", backend)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("station %q: %w", slug, err)
+		}
+		clients[s.ID()] = c
+	}
+	return clients, nil
+}
+
+func ProvideRadioService(sc streaming.Clients) radio.Service {
+	return radio.NewService(sc)
+}
+
+func ProvideIdentityService(cfg config.Config) (appidentity.Service, error) {
+	secret, ttl, _ := cfg.Identity()
+	signer, err := identity.NewHMACSigner(secret)
+	if err != nil {
+		return nil, err
+	}
+	return appidentity.NewService(signer, ttl), nil
+}
+
+func ProvideIdentityMiddleware(svc appidentity.Service, cfg config.Config) *middleware.IdentityMiddleware {
+	_, _, allowHeader := cfg.Identity()
+	return middleware.NewIdentityMiddleware(svc, allowHeader)
+}
+
+func ProvideIdentityRateLimit(cfg config.Config, client *redis.Client) *middleware.RateLimitMiddleware {
+	limit, window := cfg.IdentityRateLimit()
+	return middleware.NewRateLimitMiddleware(client, "identity", limit, window)
+}
+
+func ProvideAPIKeyMiddleware(auth *appapikey.AuthenticateHandler) *middleware.APIKeyMiddleware {
+	return middleware.NewAPIKeyMiddleware(auth)
+}
+
+func ProvideStationMiddleware(reg *appstation.Registry) *middleware.StationMiddleware {
+	return middleware.NewStationMiddleware(reg)
 }
 
-func ProvideRadioService(ic icecast.Client) radio.Service {
-	return radio.NewService(ic)
+func ProvideStatisticsRegistry(cfg config.Config) *statistics.Registry {
+	return statistics.NewDefaultRegistry(cfg.StatisticsLimit())
 }
 
-func ProvideStatisticsService(repo *postgres.StatisticsRepository) statistics.Service {
-	return statistics.NewService(repo)
+func ProvideStatisticsService(repo *postgres.StatisticsRepository, reg *statistics.Registry) statistics.Service {
+	return statistics.NewService(repo, reg)
 }
 
-func ProvideListenerService(ic icecast.Client, la *postgres.ListenerAdapter, ta *postgres.TrackListenerAdapter, log *logger.Logger) listener.Service {
-	return listener.NewService(ic, la, ta, log)
+func ProvideListenerSessionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ListenerSessionRepository {
+	return postgres.NewListenerSessionRepository(pool, m)
 }
 
-func ProvideTrackHandler(uh *apptrack.UpsertTrackHandler, gh *apptrack.GetTrackHandler) *handler.TrackHandler {
-	return handler.NewTrackHandler(uh, gh)
+func ProvideListenerSessionDomainRepository(repo *postgres.ListenerSessionRepository) domainlistener.SessionRepository {
+	return repo
 }
 
-func ProvideReactionHandler(ah *appreaction.AddReactionHandler, ch *appreaction.CheckReactionHandler) *handler.ReactionHandler {
-	return handler.NewReactionHandler(ah, ch)
+func ProvideSessionTracker(repo domainlistener.SessionRepository) *listener.SessionTracker {
+	return listener.NewSessionTracker(repo)
+}
+
+func ProvideListenerService(sc streaming.Clients, la *postgres.ListenerAdapter, ta *postgres.TrackListenerAdapter, st *listener.SessionTracker, log *logger.Logger) listener.Service {
+	return listener.NewService(sc, la, ta, st, log)
+}
+
+func ProvideTrackHandler(uh *apptrack.UpsertTrackHandler, gh *apptrack.GetTrackHandler, lh *apptrack.ListTracksHandler) *handler.TrackHandler {
+	return handler.NewTrackHandler(uh, gh, lh)
+}
+
+func ProvideReactionHandler(ah *appreaction.AddReactionHandler, ch *appreaction.CheckReactionHandler, cgh *appreaction.ChangeReactionHandler, rmh *appreaction.RemoveReactionHandler, lth *appreaction.ListReactionTypesHandler) *handler.ReactionHandler {
+	return handler.NewReactionHandler(ah, ch, cgh, rmh, lth)
 }
 
 func ProvideRadioHandler(svc radio.Service) *handler.RadioHandler {
@@ -165,24 +514,111 @@ func ProvideStatisticsHandler(svc statistics.Service) *handler.StatisticsHandler
 	return handler.NewStatisticsHandler(svc)
 }
 
-func ProvideHealthHandler(pool *pgxpool.Pool, redisClient *redis.Client) *handler.HealthHandler {
-	return handler.NewHealthHandler(pool, redisClient)
+func ProvideHistoryHandler(gh *appplay.GetHistoryHandler) *handler.HistoryHandler {
+	return handler.NewHistoryHandler(gh)
+}
+
+func ProvideNowPlayingHandler(np *nowplaying.Broadcaster) *handler.NowPlayingHandler {
+	return handler.NewNowPlayingHandler(np)
+}
+
+func ProvideLiveHub(client *redis.Client, log *logger.Logger) *live.Hub {
+	return live.NewHub(presence.NewTracker(client), presence.TTL/3, log)
+}
+
+func ProvideLiveGateway(hub *live.Hub, ah *appreaction.AddReactionHandler, log *logger.Logger) *live.Gateway {
+	return live.NewGateway(hub, ah, log)
+}
+
+func ProvideArtistHandler(lh *appartist.ListArtistsHandler, gh *appartist.GetArtistHandler, lth *apptrack.ListTracksHandler) *handler.ArtistHandler {
+	return handler.NewArtistHandler(lh, gh, lth)
+}
+
+func ProvideIdentityHandler(svc appidentity.Service) *handler.IdentityHandler {
+	return handler.NewIdentityHandler(svc)
+}
+
+func ProvideJobHandler(sched scheduler.Scheduler) *handler.JobHandler {
+	return handler.NewJobHandler(sched)
+}
+
+func ProvideStationHandler(reg *appstation.Registry) *handler.StationHandler {
+	return handler.NewStationHandler(reg)
+}
+
+func ProvideWebhookHandler(ch *appwebhook.CreateWebhookHandler, lh *appwebhook.ListWebhooksHandler, dh *appwebhook.DeleteWebhookHandler, ldh *appwebhook.ListDeliveriesHandler) *handler.WebhookHandler {
+	return handler.NewWebhookHandler(ch, lh, dh, ldh)
+}
+
+func ProvideHealthHandler(pool *pgxpool.Pool, redisClient *redis.Client, le *leader.Elector, sc streaming.Clients) *handler.HealthHandler {
+	return handler.NewHealthHandler(pool, redisClient, le, sc)
+}
+
+func ProvideRouter(th *handler.TrackHandler, rh *handler.ReactionHandler, rah *handler.RadioHandler, sh *handler.StatisticsHandler, hih *handler.HistoryHandler, nph *handler.NowPlayingHandler, ah *handler.ArtistHandler, ih *handler.IdentityHandler, hh *handler.HealthHandler, wbh *handler.WebhookHandler, jh *handler.JobHandler, sth *handler.StationHandler, lg *live.Gateway, im *middleware.IdentityMiddleware, irl *middleware.RateLimitMiddleware, akm *middleware.APIKeyMiddleware, stm *middleware.StationMiddleware, m *metrics.Metrics) *server.Router {
+	return server.NewRouter(th, rh, rah, sh, hih, nph, ah, ih, hh, wbh, jh, sth, lg, im, irl, akm, stm, m)
+}
+
+func ProvideServer(router *server.Router, m *metrics.Metrics, cfg config.Config, log *logger.Logger) *server.Server {
+	srv := server.NewServer(router, log)
+	if cfg.MetricsPort() == 0 {
+		srv.MountMetrics(m)
+	}
+	return srv
+}
+
+func ProvideAdminServer(m *metrics.Metrics, log *logger.Logger) *server.AdminServer {
+	return server.NewAdminServer(m, log)
+}
+
+func ProvideLeaderElector(pool *pgxpool.Pool, cfg config.Config, m *metrics.Metrics, log *logger.Logger) *leader.Elector {
+	return leader.NewElector(pool, "hub:scheduler", cfg.LeaderElectionInterval(), m, log)
 }
 
-func ProvideRouter(th *handler.TrackHandler, rh *handler.ReactionHandler, rah *handler.RadioHandler, sh *handler.StatisticsHandler, hh *handler.HealthHandler) *server.Router {
-	return server.NewRouter(th, rh, rah, sh, hh)
+func ProvideJobLocks(pool *pgxpool.Pool) *leader.Locks {
+	return leader.NewLocks(pool)
 }
 
-func ProvideServer(router *server.Router, log *logger.Logger) *server.Server {
-	return server.NewServer(router, log)
+func ProvideJobRunStore(pool *pgxpool.Pool, m *metrics.Metrics) job.RunStore {
+	return postgres.NewJobRunRepository(pool, m)
 }
 
-func ProvideScheduler(ls listener.Service, log *logger.Logger) scheduler.Scheduler {
-	return scheduler.NewScheduler(ls, log)
+func ProvideScheduler(ls listener.Service, pub appshared.BroadcastPublisher, reg *appstation.Registry, le *leader.Elector, locks *leader.Locks, store job.RunStore, ob outbox.Store, cfg config.Config, m *metrics.Metrics, log *logger.Logger) (scheduler.Scheduler, error) {
+	sched := scheduler.NewScheduler(le, locks, store, m, log)
+
+	jobs := []job.Job{
+		job.NewTrackListeners(ls, pub, reg, m),
+		job.NewPruneJobRuns(store, log),
+		job.NewPruneOutbox(ob, log),
+	}
+	for _, j := range jobs {
+		spec, enabled, overlap, timeout := cfg.Job(j.Name())
+		opts := job.Options{Spec: spec, Enabled: enabled, Overlap: overlap, Timeout: timeout}
+		if err := sched.Register(j, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// A streaming server request that outlasts a run, retries included,
+	// would time the poll out before a hung server is given up on
+	if _, enabled, _, timeout := cfg.Job(job.TrackListeners); enabled && timeout > 0 {
+		if budget := streaming.RequestBudget(cfg.StreamingHTTP()); budget >= timeout {
+			return nil, fmt.Errorf("job %s: timeout %s must exceed %s, the longest a streaming server request can take", job.TrackListeners, timeout, budget)
+		}
+	}
+
+	return sched, nil
+}
+
+func ProvideApplication(cfg config.Config, log *logger.Logger, db database.Database, srv *server.Server, admin *server.AdminServer, sched scheduler.Scheduler, relay *outbox.Relay, ww *webhook.Worker, bus *events.StreamBus, lh *live.Hub) *Application {
+	return &Application{Config: cfg, Logger: log, Database: db, Server: srv, AdminServer: admin, Scheduler: sched, OutboxRelay: relay, Webhooks: ww, EventBus: bus, Live: lh}
 }
 
-func ProvideApplication(cfg config.Config, log *logger.Logger, db database.Database, srv *server.Server, sched scheduler.Scheduler) *Application {
-	return &Application{Config: cfg, Logger: log, Database: db, Server: srv, Scheduler: sched}
+func ProvideTracksApp(cfg config.Config, log *logger.Logger, db database.Database, reg *appstation.Registry, bh *apptrack.BackfillNamesHandler) *TracksApp {
+	return &TracksApp{Config: cfg, Logger: log, Database: db, Stations: reg, BackfillNames: bh}
+}
+
+func ProvideAPIKeyApp(cfg config.Config, log *logger.Logger, db database.Database, reg *appstation.Registry, ch *appapikey.CreateAPIKeyHandler, lh *appapikey.ListAPIKeysHandler, rh *appapikey.RevokeAPIKeyHandler) *APIKeyApp {
+	return &APIKeyApp{Config: cfg, Logger: log, Database: db, Stations: reg, Create: ch, List: lh, Revoke: rh}
 }
 
 func ProvideMigrateApp(cfg config.Config, log *logger.Logger, dsn string) *MigrateApp {
@@ -190,15 +626,39 @@ func ProvideMigrateApp(cfg config.Config, log *logger.Logger, dsn string) *Migra
 }
 
 var ProviderSet = wire.NewSet(
-	ProvideConfig, ProvideLogger, ProvideDSN, ProvideDatabase, ProvidePool, ProvideEventPublisher,
-	ProvideCache, ProvideRedisClient, ProvideMetrics, ProvideUnitOfWork,
-	ProvideTrackRepository, ProvideTrackDomainRepository, ProvideReactionRepository,
-	ProvideListenerRepository, ProvideStatisticsRepository,
-	ProvideListenerAdapter, ProvideTrackListenerAdapter,
-	ProvideUpsertTrackHandler, ProvideGetTrackHandler, ProvideAddReactionHandler, ProvideCheckReactionHandler,
-	ProvideIcecastClient, ProvideRadioService, ProvideStatisticsService, ProvideListenerService,
-	ProvideTrackHandler, ProvideReactionHandler, ProvideRadioHandler, ProvideStatisticsHandler, ProvideHealthHandler,
-	ProvideRouter, ProvideServer, ProvideScheduler, ProvideApplication,
+	ProvideConfig, ProvideLogger, ProvideDSN, ProvideDatabase, ProvidePool, ProvideEventPublisher, ProvideBroadcastHandlers, ProvideEventBus, ProvideEventRouting, ProvideBroadcastPublisher,
+	ProvideCache, ProvideRedisClient, ProvideMetrics, ProvideUnitOfWork, ProvideOutboxStore, ProvideOutboxRelay,
+	ProvideStationRepository, ProvideStationRegistry, ProvideStationMiddleware, ProvideStationHandler,
+	ProvideTrackRepository, ProvideTrackDomainRepository, ProvideReactionRepository, ProvideReactionDomainRepository, ProvideReactionTypeRepository,
+	ProvideListenerRepository, ProvideStatisticsRepository, ProvidePlayRepository, ProvidePlayDomainRepository,
+	ProvideArtistRepository, ProvideListenerAdapter, ProvideTrackListenerAdapter,
+	ProvideTitleParser, ProvideUpsertTrackHandler, ProvideGetTrackHandler, ProvideListTracksHandler, ProvideAddReactionHandler, ProvideCheckReactionHandler,
+	ProvideChangeReactionHandler, ProvideRemoveReactionHandler, ProvideListReactionTypesHandler,
+	ProvideRecordPlayHandler, ProvideGetHistoryHandler, ProvideNowPlayingBroadcaster, ProvideListArtistsHandler, ProvideGetArtistHandler,
+	ProvideStreamingClients, ProvideRadioService, ProvideStatisticsRegistry, ProvideStatisticsService, ProvideListenerService,
+	ProvideListenerSessionRepository, ProvideListenerSessionDomainRepository, ProvideSessionTracker,
+	ProvideIdentityService, ProvideIdentityMiddleware, ProvideIdentityRateLimit,
+	ProvideAPIKeyRepository, ProvideAuthenticateHandler, ProvideAPIKeyMiddleware,
+	ProvideTrackHandler, ProvideReactionHandler, ProvideRadioHandler, ProvideStatisticsHandler, ProvideHistoryHandler,
+	ProvideNowPlayingHandler, ProvideArtistHandler, ProvideIdentityHandler, ProvideHealthHandler,
+	ProvideLiveHub, ProvideLiveGateway,
+	ProvideWebhookRepository, ProvideWebhookDeliveryRepository, ProvideCreateWebhookHandler, ProvideListWebhooksHandler,
+	ProvideDeleteWebhookHandler, ProvideListDeliveriesHandler, ProvideEnqueueDeliveriesHandler, ProvideWebhookWorker, ProvideWebhookHandler,
+	ProvideRouter, ProvideServer, ProvideAdminServer, ProvideLeaderElector, ProvideJobLocks, ProvideJobRunStore, ProvideScheduler, ProvideJobHandler, ProvideApplication,
+)
+
+var TracksProviderSet = wire.NewSet(
+	ProvideConfig, ProvideLogger, ProvideDatabase, ProvidePool, ProvideMetrics,
+	ProvideStationRepository, ProvideStationRegistry,
+	ProvideTrackRepository, ProvideTrackDomainRepository,
+	ProvideTitleParser, ProvideBackfillNamesHandler, ProvideTracksApp,
+)
+
+var APIKeyProviderSet = wire.NewSet(
+	ProvideConfig, ProvideLogger, ProvideDatabase, ProvidePool, ProvideMetrics,
+	ProvideStationRepository, ProvideStationRegistry,
+	ProvideAPIKeyRepository, ProvideCreateAPIKeyHandler, ProvideListAPIKeysHandler, ProvideRevokeAPIKeyHandler,
+	ProvideAPIKeyApp,
 )
 
 var MigrateProviderSet = wire.NewSet(ProvideConfig, ProvideLogger, ProvideDSN, ProvideMigrateApp)
@@ -208,6 +668,16 @@ func InitializeApp() (*Application, func(), error) {
 	return nil, nil, nil
 }
 
+func InitializeTracksApp() (*TracksApp, func(), error) {
+	wire.Build(TracksProviderSet)
+	return nil, nil, nil
+}
+
+func InitializeAPIKeyApp() (*APIKeyApp, func(), error) {
+	wire.Build(APIKeyProviderSet)
+	return nil, nil, nil
+}
+
 func InitializeMigrateApp() (*MigrateApp, error) {
 	wire.Build(MigrateProviderSet)
 	return nil, nil
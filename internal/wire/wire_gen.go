@@ -7,26 +7,52 @@
 package wire
 
 import (
+	"context"
+	"fmt"
 	"github.com/google/wire"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
-	"hub/internal/application/listener"
+	"hub/internal/application/apikey"
+	artist2 "hub/internal/application/artist"
+	"hub/internal/application/identity"
+	listener2 "hub/internal/application/listener"
+	"hub/internal/application/nowplaying"
+	"hub/internal/application/play"
 	"hub/internal/application/radio"
 	reaction2 "hub/internal/application/reaction"
 	"hub/internal/application/shared"
+	"hub/internal/application/station"
 	"hub/internal/application/statistics"
-	track2 "hub/internal/application/track"
+	"hub/internal/application/track"
+	webhook2 "hub/internal/application/webhook"
 	"hub/internal/config"
 	"hub/internal/database"
+	apikey2 "hub/internal/domain/apikey"
+	"hub/internal/domain/artist"
+	"hub/internal/domain/listener"
+	play2 "hub/internal/domain/play"
 	"hub/internal/domain/reaction"
-	"hub/internal/domain/track"
+	station2 "hub/internal/domain/station"
+	track2 "hub/internal/domain/track"
+	webhook3 "hub/internal/domain/webhook"
+	"hub/internal/infrastructure/breaker"
 	"hub/internal/infrastructure/cache"
 	"hub/internal/infrastructure/events"
 	"hub/internal/infrastructure/icecast"
+	identity2 "hub/internal/infrastructure/identity"
+	"hub/internal/infrastructure/leader"
 	"hub/internal/infrastructure/metrics"
+	"hub/internal/infrastructure/outbox"
 	"hub/internal/infrastructure/persistence/postgres"
+	"hub/internal/infrastructure/presence"
 	"hub/internal/infrastructure/scheduler"
+	"hub/internal/infrastructure/scheduler/job"
+	"hub/internal/infrastructure/shoutcast"
+	"hub/internal/infrastructure/streaming"
+	"hub/internal/infrastructure/webhook"
 	"hub/internal/interfaces/http/handler"
+	"hub/internal/interfaces/http/live"
+	"hub/internal/interfaces/http/middleware"
 	"hub/internal/interfaces/http/server"
 	"hub/internal/logger"
 )
@@ -38,43 +64,155 @@ func InitializeApp() (*Application, func(), error) {
 	logger := ProvideLogger(config)
 	database := ProvideDatabase(config, logger)
 	pool := ProvidePool(database)
-	trackRepository := ProvideTrackRepository(pool)
+	metrics := ProvideMetrics()
+	trackRepository := ProvideTrackRepository(pool, metrics)
 	repository := ProvideTrackDomainRepository(trackRepository)
-	eventPublisher := ProvideEventPublisher()
-	upsertTrackHandler := ProvideUpsertTrackHandler(repository, eventPublisher)
-	getTrackHandler := ProvideGetTrackHandler(repository)
-	trackHandler := ProvideTrackHandler(upsertTrackHandler, getTrackHandler)
-	reactionRepository := ProvideReactionRepository(pool)
-	addReactionHandler := ProvideAddReactionHandler(reactionRepository, repository, eventPublisher)
-	checkReactionHandler := ProvideCheckReactionHandler(reactionRepository)
-	reactionHandler := ProvideReactionHandler(addReactionHandler, checkReactionHandler)
-	client, err := ProvideIcecastClient(config)
+	titleParser := ProvideTitleParser(config)
+	unitOfWork := ProvideUnitOfWork(pool)
+	store := ProvideOutboxStore(pool, metrics)
+	eventPublisher := ProvideEventPublisher(store)
+	upsertTrackHandler := ProvideUpsertTrackHandler(repository, titleParser, unitOfWork, eventPublisher)
+	reactionRepository := ProvideReactionRepository(pool, metrics)
+	playRepository := ProvidePlayRepository(pool, metrics)
+	getTrackHandler := ProvideGetTrackHandler(repository, reactionRepository, playRepository)
+	listTracksHandler := ProvideListTracksHandler(repository)
+	trackHandler := ProvideTrackHandler(upsertTrackHandler, getTrackHandler, listTracksHandler)
+	repository2 := ProvideReactionDomainRepository(reactionRepository)
+	typeRepository := ProvideReactionTypeRepository(pool, metrics)
+	addReactionHandler := ProvideAddReactionHandler(repository2, typeRepository, repository, unitOfWork, eventPublisher)
+	checkReactionHandler := ProvideCheckReactionHandler(repository2)
+	changeReactionHandler := ProvideChangeReactionHandler(repository2, typeRepository, unitOfWork, eventPublisher)
+	removeReactionHandler := ProvideRemoveReactionHandler(repository2, unitOfWork, eventPublisher)
+	listReactionTypesHandler := ProvideListReactionTypesHandler(typeRepository)
+	reactionHandler := ProvideReactionHandler(addReactionHandler, checkReactionHandler, changeReactionHandler, removeReactionHandler, listReactionTypesHandler)
+	stationRepository := ProvideStationRepository(pool, metrics)
+	registry, err := ProvideStationRegistry(config, stationRepository)
 	if err != nil {
 		return nil, nil, err
 	}
-	service := ProvideRadioService(client)
+	clients, err := ProvideStreamingClients(config, registry, metrics)
+	if err != nil {
+		return nil, nil, err
+	}
+	service := ProvideRadioService(clients)
 	radioHandler := ProvideRadioHandler(service)
-	statisticsRepository := ProvideStatisticsRepository(pool)
-	statisticsService := ProvideStatisticsService(statisticsRepository)
+	statisticsRepository := ProvideStatisticsRepository(pool, metrics)
+	statisticsRegistry := ProvideStatisticsRegistry(config)
+	statisticsService := ProvideStatisticsService(statisticsRepository, statisticsRegistry)
 	statisticsHandler := ProvideStatisticsHandler(statisticsService)
-	cache, err := ProvideCache(config, logger)
+	getHistoryHandler := ProvideGetHistoryHandler(playRepository)
+	historyHandler := ProvideHistoryHandler(getHistoryHandler)
+	repository3 := ProvidePlayDomainRepository(playRepository)
+	broadcaster := ProvideNowPlayingBroadcaster(getTrackHandler, repository3, registry, logger)
+	nowPlayingHandler := ProvideNowPlayingHandler(broadcaster)
+	artistRepository := ProvideArtistRepository(pool, metrics)
+	listArtistsHandler := ProvideListArtistsHandler(artistRepository)
+	getArtistHandler := ProvideGetArtistHandler(artistRepository)
+	artistHandler := ProvideArtistHandler(listArtistsHandler, getArtistHandler, listTracksHandler)
+	identityService, err := ProvideIdentityService(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	identityHandler := ProvideIdentityHandler(identityService)
+	cache, err := ProvideCache(config, logger, metrics)
 	if err != nil {
 		return nil, nil, err
 	}
-	redisClient := ProvideRedisClient(cache)
-	healthHandler := ProvideHealthHandler(pool, redisClient)
-	router := ProvideRouter(trackHandler, reactionHandler, radioHandler, statisticsHandler, healthHandler)
-	server := ProvideServer(router, logger)
-	listenerRepository := ProvideListenerRepository(pool)
+	client := ProvideRedisClient(cache)
+	elector := ProvideLeaderElector(pool, config, metrics, logger)
+	healthHandler := ProvideHealthHandler(pool, client, elector, clients)
+	webhookRepository := ProvideWebhookRepository(pool, metrics)
+	createWebhookHandler := ProvideCreateWebhookHandler(webhookRepository, registry)
+	listWebhooksHandler := ProvideListWebhooksHandler(webhookRepository, registry)
+	deleteWebhookHandler := ProvideDeleteWebhookHandler(webhookRepository)
+	deliveryRepository := ProvideWebhookDeliveryRepository(pool, metrics)
+	listDeliveriesHandler := ProvideListDeliveriesHandler(webhookRepository, deliveryRepository)
+	webhookHandler := ProvideWebhookHandler(createWebhookHandler, listWebhooksHandler, deleteWebhookHandler, listDeliveriesHandler)
+	listenerRepository := ProvideListenerRepository(pool, metrics)
 	listenerAdapter := ProvideListenerAdapter(listenerRepository)
 	trackListenerAdapter := ProvideTrackListenerAdapter(trackRepository)
-	listenerService := ProvideListenerService(client, listenerAdapter, trackListenerAdapter, logger)
-	scheduler := ProvideScheduler(listenerService, logger)
-	application := ProvideApplication(config, logger, database, server, scheduler)
+	listenerSessionRepository := ProvideListenerSessionRepository(pool, metrics)
+	sessionRepository := ProvideListenerSessionDomainRepository(listenerSessionRepository)
+	sessionTracker := ProvideSessionTracker(sessionRepository)
+	listenerService := ProvideListenerService(clients, listenerAdapter, trackListenerAdapter, sessionTracker, logger)
+	recordPlayHandler := ProvideRecordPlayHandler(repository3, unitOfWork, service, listenerSessionRepository, config, logger)
+	enqueueDeliveriesHandler := ProvideEnqueueDeliveriesHandler(webhookRepository, deliveryRepository, registry)
+	hub := ProvideLiveHub(client, logger)
+	inMemoryPublisher := ProvideBroadcastHandlers(broadcaster, hub)
+	streamBus := ProvideEventBus(config, client, recordPlayHandler, enqueueDeliveriesHandler, inMemoryPublisher, logger)
+	broadcastPublisher, err := ProvideBroadcastPublisher(config, streamBus, inMemoryPublisher)
+	if err != nil {
+		return nil, nil, err
+	}
+	locks := ProvideJobLocks(pool)
+	runStore := ProvideJobRunStore(pool, metrics)
+	scheduler, err := ProvideScheduler(listenerService, broadcastPublisher, registry, elector, locks, runStore, store, config, metrics, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	jobHandler := ProvideJobHandler(scheduler)
+	stationHandler := ProvideStationHandler(registry)
+	gateway := ProvideLiveGateway(hub, addReactionHandler, logger)
+	identityMiddleware := ProvideIdentityMiddleware(identityService, config)
+	rateLimitMiddleware := ProvideIdentityRateLimit(config, client)
+	apikeyRepository := ProvideAPIKeyRepository(pool, metrics)
+	authenticateHandler := ProvideAuthenticateHandler(apikeyRepository, logger)
+	apiKeyMiddleware := ProvideAPIKeyMiddleware(authenticateHandler)
+	stationMiddleware := ProvideStationMiddleware(registry)
+	router := ProvideRouter(trackHandler, reactionHandler, radioHandler, statisticsHandler, historyHandler, nowPlayingHandler, artistHandler, identityHandler, healthHandler, webhookHandler, jobHandler, stationHandler, gateway, identityMiddleware, rateLimitMiddleware, apiKeyMiddleware, stationMiddleware, metrics)
+	server := ProvideServer(router, metrics, config, logger)
+	adminServer := ProvideAdminServer(metrics, logger)
+	routing, err := ProvideEventRouting(config, streamBus, recordPlayHandler, enqueueDeliveriesHandler, inMemoryPublisher)
+	if err != nil {
+		return nil, nil, err
+	}
+	relay := ProvideOutboxRelay(store, routing, config, logger)
+	worker := ProvideWebhookWorker(webhookRepository, deliveryRepository, config, logger)
+	application := ProvideApplication(config, logger, database, server, adminServer, scheduler, relay, worker, streamBus, hub)
 	return application, func() {
 	}, nil
 }
 
+func InitializeTracksApp() (*TracksApp, func(), error) {
+	config := ProvideConfig()
+	logger := ProvideLogger(config)
+	database := ProvideDatabase(config, logger)
+	pool := ProvidePool(database)
+	metrics := ProvideMetrics()
+	repository := ProvideStationRepository(pool, metrics)
+	registry, err := ProvideStationRegistry(config, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+	trackRepository := ProvideTrackRepository(pool, metrics)
+	repository2 := ProvideTrackDomainRepository(trackRepository)
+	titleParser := ProvideTitleParser(config)
+	backfillNamesHandler := ProvideBackfillNamesHandler(repository2, titleParser)
+	tracksApp := ProvideTracksApp(config, logger, database, registry, backfillNamesHandler)
+	return tracksApp, func() {
+	}, nil
+}
+
+func InitializeAPIKeyApp() (*APIKeyApp, func(), error) {
+	config := ProvideConfig()
+	logger := ProvideLogger(config)
+	database := ProvideDatabase(config, logger)
+	pool := ProvidePool(database)
+	metrics := ProvideMetrics()
+	repository := ProvideStationRepository(pool, metrics)
+	registry, err := ProvideStationRegistry(config, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+	apikeyRepository := ProvideAPIKeyRepository(pool, metrics)
+	createAPIKeyHandler := ProvideCreateAPIKeyHandler(apikeyRepository, registry)
+	listAPIKeysHandler := ProvideListAPIKeysHandler(apikeyRepository)
+	revokeAPIKeyHandler := ProvideRevokeAPIKeyHandler(apikeyRepository)
+	apiKeyApp := ProvideAPIKeyApp(config, logger, database, registry, createAPIKeyHandler, listAPIKeysHandler, revokeAPIKeyHandler)
+	return apiKeyApp, func() {
+	}, nil
+}
+
 func InitializeMigrateApp() (*MigrateApp, error) {
 	config := ProvideConfig()
 	logger := ProvideLogger(config)
@@ -87,11 +225,36 @@ func InitializeMigrateApp() (*MigrateApp, error) {
 
 // Application holds all dependencies for the serve command
 type Application struct {
-	Config    config.Config
-	Logger    *logger.Logger
-	Database  database.Database
-	Server    *server.Server
-	Scheduler scheduler.Scheduler
+	Config      config.Config
+	Logger      *logger.Logger
+	Database    database.Database
+	Server      *server.Server
+	AdminServer *server.AdminServer
+	Scheduler   scheduler.Scheduler
+	OutboxRelay *outbox.Relay
+	Webhooks    *webhook.Worker
+	EventBus    *events.StreamBus
+	Live        *live.Hub
+}
+
+// TracksApp holds dependencies for track maintenance commands
+type TracksApp struct {
+	Config        config.Config
+	Logger        *logger.Logger
+	Database      database.Database
+	Stations      *station.Registry
+	BackfillNames *track.BackfillNamesHandler
+}
+
+// APIKeyApp holds dependencies for API key commands
+type APIKeyApp struct {
+	Config   config.Config
+	Logger   *logger.Logger
+	Database database.Database
+	Stations *station.Registry
+	Create   *apikey.CreateAPIKeyHandler
+	List     *apikey.ListAPIKeysHandler
+	Revoke   *apikey.RevokeAPIKeyHandler
 }
 
 // MigrateApp holds dependencies for migrate commands
@@ -123,12 +286,82 @@ func ProvidePool(db database.Database) *pgxpool.Pool {
 	return db.Pool()
 }
 
-func ProvideEventPublisher() shared.EventPublisher {
-	return events.NewInMemoryPublisher()
+func ProvideEventPublisher(store outbox.Store) shared.EventPublisher {
+	return outbox.NewPublisher(store)
+}
+
+// workerHandlers builds one publisher per handler that must run once per
+// event across all replicas, so each is retried on its own.
+func workerHandlers(rp *play.RecordPlayHandler, wh *webhook2.EnqueueDeliveriesHandler) map[string]*events.InMemoryPublisher {
+	plays := events.NewInMemoryPublisher()
+	plays.Register(track2.EventTrackCreated, rp.HandleEvent)
+	plays.Register(track2.EventTrackRotated, rp.HandleEvent)
+
+	webhooks := events.NewInMemoryPublisher()
+	for _, name := range webhook3.Events {
+		webhooks.Register(name, wh.HandleEvent)
+	}
+
+	return map[string]*events.InMemoryPublisher{"plays": plays, "webhooks": webhooks}
+}
+
+// ProvideBroadcastHandlers registers the handlers that must run on every
+// replica, because they feed the clients connected to it.
+func ProvideBroadcastHandlers(np *nowplaying.Broadcaster, lh *live.Hub) *events.InMemoryPublisher {
+	pub := events.NewInMemoryPublisher()
+	pub.Register(track2.EventTrackCreated, np.HandleEvent)
+	pub.Register(track2.EventTrackRotated, np.HandleEvent)
+	pub.Register(reaction.EventReactionAdded, np.HandleEvent)
+	pub.Register(reaction.EventReactionChanged, np.HandleEvent)
+	pub.Register(reaction.EventReactionRemoved, np.HandleEvent)
+	pub.Register(reaction.EventReactionAdded, lh.HandleEvent)
+	pub.Register(listener.EventListenersCounted, np.HandleEvent)
+	return pub
+}
+
+func ProvideEventBus(cfg config.Config, client *redis.Client, rp *play.RecordPlayHandler, wh *webhook2.EnqueueDeliveriesHandler, broadcast *events.InMemoryPublisher, log *logger.Logger) *events.StreamBus {
+	_, stream, group, start := cfg.EventBus()
+	_, prefix := cfg.RedisConnection()
+
+	return events.NewStreamBus(client, prefix+stream, group, start, workerHandlers(rp, wh), broadcast, log)
+}
+
+// ProvideEventRouting routes relayed events. With Redis the stream is the
+// only subscriber and tracks each worker through its own consumer group;
+// in memory each worker is a subscriber of its own and the broadcast
+// handlers are kept out of retries.
+func ProvideEventRouting(cfg config.Config, bus *events.StreamBus, rp *play.RecordPlayHandler, wh *webhook2.EnqueueDeliveriesHandler, broadcast *events.InMemoryPublisher) (outbox.Routing, error) {
+	driver, _, _, _ := cfg.EventBus()
+	switch driver {
+	case config.EventBusRedis:
+		return outbox.Routing{Subscribers: map[string]outbox.Dispatcher{"bus": bus}}, nil
+	case config.EventBusMemory:
+		subscribers := make(map[string]outbox.Dispatcher)
+		for name, pub := range workerHandlers(rp, wh) {
+			subscribers[name] = pub
+		}
+		return outbox.Routing{Subscribers: subscribers, Broadcast: broadcast}, nil
+	default:
+		return outbox.Routing{}, fmt.Errorf("unknown event bus %q", driver)
+	}
+}
+
+// ProvideBroadcastPublisher publishes to the broadcast handlers of every
+// replica: through the stream with Redis, directly in memory.
+func ProvideBroadcastPublisher(cfg config.Config, bus *events.StreamBus, broadcast *events.InMemoryPublisher) (shared.BroadcastPublisher, error) {
+	driver, _, _, _ := cfg.EventBus()
+	switch driver {
+	case config.EventBusRedis:
+		return bus, nil
+	case config.EventBusMemory:
+		return broadcast, nil
+	default:
+		return nil, fmt.Errorf("unknown event bus %q", driver)
+	}
 }
 
-func ProvideCache(cfg config.Config, log *logger.Logger) (cache.Cache, error) {
-	return cache.NewCache(cfg, log)
+func ProvideCache(cfg config.Config, log *logger.Logger, m *metrics.Metrics) (cache.Cache, error) {
+	return cache.NewCache(cfg, log, m)
 }
 
 func ProvideRedisClient(c cache.Cache) *redis.Client {
@@ -139,28 +372,82 @@ func ProvideMetrics() *metrics.Metrics {
 	return metrics.NewMetrics()
 }
 
-func ProvideUnitOfWork(pool *pgxpool.Pool) *postgres.UnitOfWork {
+func ProvideUnitOfWork(pool *pgxpool.Pool) shared.UnitOfWork {
 	return postgres.NewUnitOfWork(pool)
 }
 
-func ProvideTrackRepository(pool *pgxpool.Pool) *postgres.TrackRepository {
-	return postgres.NewTrackRepository(pool)
+func ProvideOutboxStore(pool *pgxpool.Pool, m *metrics.Metrics) outbox.Store {
+	return postgres.NewOutboxRepository(pool, m)
 }
 
-func ProvideTrackDomainRepository(repo *postgres.TrackRepository) track.Repository {
+func ProvideOutboxRelay(store outbox.Store, routing outbox.Routing, cfg config.Config, log *logger.Logger) *outbox.Relay {
+	interval, batchSize, maxAttempts := cfg.Outbox()
+	return outbox.NewRelay(store, routing, interval, batchSize, maxAttempts, log)
+}
+
+func ProvideStationRepository(pool *pgxpool.Pool, m *metrics.Metrics) station2.Repository {
+	return postgres.NewStationRepository(pool, m)
+}
+
+func ProvideStationRegistry(cfg config.Config, repo station2.Repository) (*station.Registry, error) {
+	slugs := cfg.Stations()
+	defs := make([]station.Definition, len(slugs))
+	for i, slug := range slugs {
+		defs[i] = station.Definition{Slug: slug, Name: cfg.StationName(slug)}
+	}
+	return station.NewRegistry(context.Background(), repo, defs)
+}
+
+func ProvideTrackRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.TrackRepository {
+	return postgres.NewTrackRepository(pool, m)
+}
+
+func ProvideTrackDomainRepository(repo *postgres.TrackRepository) track2.Repository {
+	return repo
+}
+
+func ProvideReactionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ReactionRepository {
+	return postgres.NewReactionRepository(pool, m)
+}
+
+func ProvideReactionDomainRepository(repo *postgres.ReactionRepository) reaction.Repository {
 	return repo
 }
 
-func ProvideReactionRepository(pool *pgxpool.Pool) reaction.Repository {
-	return postgres.NewReactionRepository(pool)
+func ProvideReactionTypeRepository(pool *pgxpool.Pool, m *metrics.Metrics) reaction.TypeRepository {
+	return postgres.NewReactionTypeRepository(pool, m)
 }
 
-func ProvideListenerRepository(pool *pgxpool.Pool) *postgres.ListenerRepository {
-	return postgres.NewListenerRepository(pool)
+func ProvideListenerRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ListenerRepository {
+	return postgres.NewListenerRepository(pool, m)
 }
 
-func ProvideStatisticsRepository(pool *pgxpool.Pool) *postgres.StatisticsRepository {
-	return postgres.NewStatisticsRepository(pool)
+func ProvideStatisticsRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.StatisticsRepository {
+	return postgres.NewStatisticsRepository(pool, m)
+}
+
+func ProvidePlayRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.PlayRepository {
+	return postgres.NewPlayRepository(pool, m)
+}
+
+func ProvidePlayDomainRepository(repo *postgres.PlayRepository) play2.Repository {
+	return repo
+}
+
+func ProvideArtistRepository(pool *pgxpool.Pool, m *metrics.Metrics) artist.Repository {
+	return postgres.NewArtistRepository(pool, m)
+}
+
+func ProvideAPIKeyRepository(pool *pgxpool.Pool, m *metrics.Metrics) apikey2.Repository {
+	return postgres.NewAPIKeyRepository(pool, m)
+}
+
+func ProvideWebhookRepository(pool *pgxpool.Pool, m *metrics.Metrics) webhook3.Repository {
+	return postgres.NewWebhookRepository(pool, m)
+}
+
+func ProvideWebhookDeliveryRepository(pool *pgxpool.Pool, m *metrics.Metrics) webhook3.DeliveryRepository {
+	return postgres.NewWebhookDeliveryRepository(pool, m)
 }
 
 func ProvideListenerAdapter(repo *postgres.ListenerRepository) *postgres.ListenerAdapter {
@@ -171,44 +458,217 @@ func ProvideTrackListenerAdapter(repo *postgres.TrackRepository) *postgres.Track
 	return postgres.NewTrackListenerAdapter(repo)
 }
 
-func ProvideUpsertTrackHandler(repo track.Repository, pub shared.EventPublisher) *track2.UpsertTrackHandler {
-	return track2.NewUpsertTrackHandler(repo, pub)
+func ProvideTitleParser(cfg config.Config) *track2.TitleParser {
+	return track2.NewTitleParser(cfg.TitleSeparators())
+}
+
+func ProvideUpsertTrackHandler(repo track2.Repository, parser *track2.TitleParser, uow shared.UnitOfWork, pub shared.EventPublisher) *track.UpsertTrackHandler {
+	return track.NewUpsertTrackHandler(repo, parser, uow, pub)
+}
+
+func ProvideGetTrackHandler(repo track2.Repository, rr *postgres.ReactionRepository, pr *postgres.PlayRepository) *track.GetTrackHandler {
+	return track.NewGetTrackHandler(repo, rr, pr)
+}
+
+func ProvideListTracksHandler(repo track2.Repository) *track.ListTracksHandler {
+	return track.NewListTracksHandler(repo)
 }
 
-func ProvideGetTrackHandler(repo track.Repository) *track2.GetTrackHandler {
-	return track2.NewGetTrackHandler(repo)
+func ProvideBackfillNamesHandler(repo track2.Repository, parser *track2.TitleParser) *track.BackfillNamesHandler {
+	return track.NewBackfillNamesHandler(repo, parser)
 }
 
-func ProvideAddReactionHandler(rr reaction.Repository, tr track.Repository, pub shared.EventPublisher) *reaction2.AddReactionHandler {
-	return reaction2.NewAddReactionHandler(rr, tr, pub)
+func ProvideListArtistsHandler(repo artist.Repository) *artist2.ListArtistsHandler {
+	return artist2.NewListArtistsHandler(repo)
+}
+
+func ProvideGetArtistHandler(repo artist.Repository) *artist2.GetArtistHandler {
+	return artist2.NewGetArtistHandler(repo)
+}
+
+func ProvideAddReactionHandler(rr reaction.Repository, rtr reaction.TypeRepository, tr track2.Repository, uow shared.UnitOfWork, pub shared.EventPublisher) *reaction2.AddReactionHandler {
+	return reaction2.NewAddReactionHandler(rr, rtr, tr, uow, pub)
 }
 
 func ProvideCheckReactionHandler(rr reaction.Repository) *reaction2.CheckReactionHandler {
 	return reaction2.NewCheckReactionHandler(rr)
 }
 
-func ProvideIcecastClient(cfg config.Config) (icecast.Client, error) {
-	return icecast.NewClient(cfg)
+func ProvideChangeReactionHandler(rr reaction.Repository, rtr reaction.TypeRepository, uow shared.UnitOfWork, pub shared.EventPublisher) *reaction2.ChangeReactionHandler {
+	return reaction2.NewChangeReactionHandler(rr, rtr, uow, pub)
+}
+
+func ProvideRemoveReactionHandler(rr reaction.Repository, uow shared.UnitOfWork, pub shared.EventPublisher) *reaction2.RemoveReactionHandler {
+	return reaction2.NewRemoveReactionHandler(rr, uow, pub)
+}
+
+func ProvideListReactionTypesHandler(rtr reaction.TypeRepository) *reaction2.ListReactionTypesHandler {
+	return reaction2.NewListReactionTypesHandler(rtr)
+}
+
+func ProvideCreateAPIKeyHandler(repo apikey2.Repository, reg *station.Registry) *apikey.CreateAPIKeyHandler {
+	return apikey.NewCreateAPIKeyHandler(repo, reg)
 }
 
-func ProvideRadioService(ic icecast.Client) radio.Service {
-	return radio.NewService(ic)
+func ProvideListAPIKeysHandler(repo apikey2.Repository) *apikey.ListAPIKeysHandler {
+	return apikey.NewListAPIKeysHandler(repo)
 }
 
-func ProvideStatisticsService(repo *postgres.StatisticsRepository) statistics.Service {
-	return statistics.NewService(repo)
+func ProvideRevokeAPIKeyHandler(repo apikey2.Repository) *apikey.RevokeAPIKeyHandler {
+	return apikey.NewRevokeAPIKeyHandler(repo)
 }
 
-func ProvideListenerService(ic icecast.Client, la *postgres.ListenerAdapter, ta *postgres.TrackListenerAdapter, log *logger.Logger) listener.Service {
-	return listener.NewService(ic, la, ta, log)
+func ProvideAuthenticateHandler(repo apikey2.Repository, log *logger.Logger) *apikey.AuthenticateHandler {
+	return apikey.NewAuthenticateHandler(repo, log)
 }
 
-func ProvideTrackHandler(uh *track2.UpsertTrackHandler, gh *track2.GetTrackHandler) *handler.TrackHandler {
-	return handler.NewTrackHandler(uh, gh)
+func ProvideCreateWebhookHandler(repo webhook3.Repository, reg *station.Registry) *webhook2.CreateWebhookHandler {
+	return webhook2.NewCreateWebhookHandler(repo, reg)
 }
 
-func ProvideReactionHandler(ah *reaction2.AddReactionHandler, ch *reaction2.CheckReactionHandler) *handler.ReactionHandler {
-	return handler.NewReactionHandler(ah, ch)
+func ProvideListWebhooksHandler(repo webhook3.Repository, reg *station.Registry) *webhook2.ListWebhooksHandler {
+	return webhook2.NewListWebhooksHandler(repo, reg)
+}
+
+func ProvideDeleteWebhookHandler(repo webhook3.Repository) *webhook2.DeleteWebhookHandler {
+	return webhook2.NewDeleteWebhookHandler(repo)
+}
+
+func ProvideListDeliveriesHandler(repo webhook3.Repository, dr webhook3.DeliveryRepository) *webhook2.ListDeliveriesHandler {
+	return webhook2.NewListDeliveriesHandler(repo, dr)
+}
+
+func ProvideEnqueueDeliveriesHandler(repo webhook3.Repository, dr webhook3.DeliveryRepository, reg *station.Registry) *webhook2.EnqueueDeliveriesHandler {
+	return webhook2.NewEnqueueDeliveriesHandler(repo, dr, reg)
+}
+
+func ProvideWebhookWorker(repo webhook3.Repository, dr webhook3.DeliveryRepository, cfg config.Config, log *logger.Logger) *webhook.Worker {
+	interval, timeout, maxAttempts := cfg.Webhooks()
+	return webhook.NewWorker(repo, dr, interval, timeout, maxAttempts, log)
+}
+
+func ProvideRecordPlayHandler(repo play2.Repository, uow shared.UnitOfWork, svc radio.Service, sr *postgres.ListenerSessionRepository, cfg config.Config, log *logger.Logger) *play.RecordPlayHandler {
+	return play.NewRecordPlayHandler(repo, uow, svc, sr, cfg.TuneOutWindow(), log)
+}
+
+func ProvideGetHistoryHandler(repo *postgres.PlayRepository) *play.GetHistoryHandler {
+	return play.NewGetHistoryHandler(repo)
+}
+
+// ProvideNowPlayingBroadcaster creates the broadcaster and seeds the track on
+// air of every station; a station that can't be seeded starts empty.
+func ProvideNowPlayingBroadcaster(gh *track.GetTrackHandler, repo play2.Repository, reg *station.Registry, log *logger.Logger) *nowplaying.Broadcaster {
+	np := nowplaying.NewBroadcaster(gh, repo, log)
+	for _, s := range reg.All() {
+		ctx := shared.WithStationID(context.Background(), s.ID())
+		if err := np.Seed(ctx); err != nil {
+			log.WithContext("nowplaying", "seed").WithError(err).WithField("station", s.Slug()).Warn("failed to seed now playing")
+		}
+	}
+	return np
+}
+
+// ProvideStreamingClients creates the streaming server client of every
+// station with the adapter of its backend, each with its own circuit breaker.
+func ProvideStreamingClients(cfg config.Config, reg *station.Registry, m *metrics.Metrics) (streaming.Clients, error) {
+	timeout, retries, backoff := cfg.StreamingHTTP()
+	failures, cooldown := cfg.StreamingBreaker()
+
+	stations := reg.All()
+	clients := make(streaming.Clients, len(stations))
+	for _, s := range stations {
+		slug := s.Slug()
+		host, user, password, mounts := cfg.StreamingConnection(slug)
+
+		br := breaker.New(slug, failures, cooldown, func(state breaker.State) {
+			m.SetCircuitBreakerState(slug, int(state))
+		})
+		m.SetCircuitBreakerState(slug, int(br.State()))
+		http := streaming.NewHTTPClient(timeout, retries, backoff, br)
+
+		var (
+			c   streaming.Client
+			err error
+		)
+		switch backend := cfg.StreamingBackend(slug); backend {
+		case config.IcecastAdmin:
+			c, err = icecast.NewClient(host, user, password, mounts, http)
+		case config.IcecastStatus:
+			c, err = icecast.NewStatusClient(host, mounts, http)
+		case config.ShoutcastV2:
+			c, err = shoutcast.NewClient(host, user, password, mounts, http)
+		default:
+			err = fmt.Errorf("unknown streaming backend %q", backend)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("station %q: %w", slug, err)
+		}
+		clients[s.ID()] = c
+	}
+	return clients, nil
+}
+
+func ProvideRadioService(sc streaming.Clients) radio.Service {
+	return radio.NewService(sc)
+}
+
+func ProvideIdentityService(cfg config.Config) (identity.Service, error) {
+	secret, ttl, _ := cfg.Identity()
+	signer, err := identity2.NewHMACSigner(secret)
+	if err != nil {
+		return nil, err
+	}
+	return identity.NewService(signer, ttl), nil
+}
+
+func ProvideIdentityMiddleware(svc identity.Service, cfg config.Config) *middleware.IdentityMiddleware {
+	_, _, allowHeader := cfg.Identity()
+	return middleware.NewIdentityMiddleware(svc, allowHeader)
+}
+
+func ProvideIdentityRateLimit(cfg config.Config, client *redis.Client) *middleware.RateLimitMiddleware {
+	limit, window := cfg.IdentityRateLimit()
+	return middleware.NewRateLimitMiddleware(client, "identity", limit, window)
+}
+
+func ProvideAPIKeyMiddleware(auth *apikey.AuthenticateHandler) *middleware.APIKeyMiddleware {
+	return middleware.NewAPIKeyMiddleware(auth)
+}
+
+func ProvideStationMiddleware(reg *station.Registry) *middleware.StationMiddleware {
+	return middleware.NewStationMiddleware(reg)
+}
+
+func ProvideStatisticsRegistry(cfg config.Config) *statistics.Registry {
+	return statistics.NewDefaultRegistry(cfg.StatisticsLimit())
+}
+
+func ProvideStatisticsService(repo *postgres.StatisticsRepository, reg *statistics.Registry) statistics.Service {
+	return statistics.NewService(repo, reg)
+}
+
+func ProvideListenerSessionRepository(pool *pgxpool.Pool, m *metrics.Metrics) *postgres.ListenerSessionRepository {
+	return postgres.NewListenerSessionRepository(pool, m)
+}
+
+func ProvideListenerSessionDomainRepository(repo *postgres.ListenerSessionRepository) listener.SessionRepository {
+	return repo
+}
+
+func ProvideSessionTracker(repo listener.SessionRepository) *listener2.SessionTracker {
+	return listener2.NewSessionTracker(repo)
+}
+
+func ProvideListenerService(sc streaming.Clients, la *postgres.ListenerAdapter, ta *postgres.TrackListenerAdapter, st *listener2.SessionTracker, log *logger.Logger) listener2.Service {
+	return listener2.NewService(sc, la, ta, st, log)
+}
+
+func ProvideTrackHandler(uh *track.UpsertTrackHandler, gh *track.GetTrackHandler, lh *track.ListTracksHandler) *handler.TrackHandler {
+	return handler.NewTrackHandler(uh, gh, lh)
+}
+
+func ProvideReactionHandler(ah *reaction2.AddReactionHandler, ch *reaction2.CheckReactionHandler, cgh *reaction2.ChangeReactionHandler, rmh *reaction2.RemoveReactionHandler, lth *reaction2.ListReactionTypesHandler) *handler.ReactionHandler {
+	return handler.NewReactionHandler(ah, ch, cgh, rmh, lth)
 }
 
 func ProvideRadioHandler(svc radio.Service) *handler.RadioHandler {
@@ -219,24 +679,105 @@ func ProvideStatisticsHandler(svc statistics.Service) *handler.StatisticsHandler
 	return handler.NewStatisticsHandler(svc)
 }
 
-func ProvideHealthHandler(pool *pgxpool.Pool, redisClient *redis.Client) *handler.HealthHandler {
-	return handler.NewHealthHandler(pool, redisClient)
+func ProvideHistoryHandler(gh *play.GetHistoryHandler) *handler.HistoryHandler {
+	return handler.NewHistoryHandler(gh)
+}
+
+func ProvideNowPlayingHandler(np *nowplaying.Broadcaster) *handler.NowPlayingHandler {
+	return handler.NewNowPlayingHandler(np)
+}
+
+func ProvideLiveHub(client *redis.Client, log *logger.Logger) *live.Hub {
+	return live.NewHub(presence.NewTracker(client), presence.TTL/3, log)
+}
+
+func ProvideLiveGateway(hub *live.Hub, ah *reaction2.AddReactionHandler, log *logger.Logger) *live.Gateway {
+	return live.NewGateway(hub, ah, log)
+}
+
+func ProvideArtistHandler(lh *artist2.ListArtistsHandler, gh *artist2.GetArtistHandler, lth *track.ListTracksHandler) *handler.ArtistHandler {
+	return handler.NewArtistHandler(lh, gh, lth)
 }
 
-func ProvideRouter(th *handler.TrackHandler, rh *handler.ReactionHandler, rah *handler.RadioHandler, sh *handler.StatisticsHandler, hh *handler.HealthHandler) *server.Router {
-	return server.NewRouter(th, rh, rah, sh, hh)
+func ProvideIdentityHandler(svc identity.Service) *handler.IdentityHandler {
+	return handler.NewIdentityHandler(svc)
 }
 
-func ProvideServer(router *server.Router, log *logger.Logger) *server.Server {
-	return server.NewServer(router, log)
+func ProvideJobHandler(sched scheduler.Scheduler) *handler.JobHandler {
+	return handler.NewJobHandler(sched)
+}
+
+func ProvideStationHandler(reg *station.Registry) *handler.StationHandler {
+	return handler.NewStationHandler(reg)
+}
+
+func ProvideWebhookHandler(ch *webhook2.CreateWebhookHandler, lh *webhook2.ListWebhooksHandler, dh *webhook2.DeleteWebhookHandler, ldh *webhook2.ListDeliveriesHandler) *handler.WebhookHandler {
+	return handler.NewWebhookHandler(ch, lh, dh, ldh)
+}
+
+func ProvideHealthHandler(pool *pgxpool.Pool, redisClient *redis.Client, le *leader.Elector, sc streaming.Clients) *handler.HealthHandler {
+	return handler.NewHealthHandler(pool, redisClient, le, sc)
+}
+
+func ProvideRouter(th *handler.TrackHandler, rh *handler.ReactionHandler, rah *handler.RadioHandler, sh *handler.StatisticsHandler, hih *handler.HistoryHandler, nph *handler.NowPlayingHandler, ah *handler.ArtistHandler, ih *handler.IdentityHandler, hh *handler.HealthHandler, wbh *handler.WebhookHandler, jh *handler.JobHandler, sth *handler.StationHandler, lg *live.Gateway, im *middleware.IdentityMiddleware, irl *middleware.RateLimitMiddleware, akm *middleware.APIKeyMiddleware, stm *middleware.StationMiddleware, m *metrics.Metrics) *server.Router {
+	return server.NewRouter(th, rh, rah, sh, hih, nph, ah, ih, hh, wbh, jh, sth, lg, im, irl, akm, stm, m)
+}
+
+func ProvideServer(router *server.Router, m *metrics.Metrics, cfg config.Config, log *logger.Logger) *server.Server {
+	srv := server.NewServer(router, log)
+	if cfg.MetricsPort() == 0 {
+		srv.MountMetrics(m)
+	}
+	return srv
 }
 
-func ProvideScheduler(ls listener.Service, log *logger.Logger) scheduler.Scheduler {
-	return scheduler.NewScheduler(ls, log)
+func ProvideAdminServer(m *metrics.Metrics, log *logger.Logger) *server.AdminServer {
+	return server.NewAdminServer(m, log)
 }
 
-func ProvideApplication(cfg config.Config, log *logger.Logger, db database.Database, srv *server.Server, sched scheduler.Scheduler) *Application {
-	return &Application{Config: cfg, Logger: log, Database: db, Server: srv, Scheduler: sched}
+func ProvideLeaderElector(pool *pgxpool.Pool, cfg config.Config, m *metrics.Metrics, log *logger.Logger) *leader.Elector {
+	return leader.NewElector(pool, "hub:scheduler", cfg.LeaderElectionInterval(), m, log)
+}
+
+func ProvideJobLocks(pool *pgxpool.Pool) *leader.Locks {
+	return leader.NewLocks(pool)
+}
+
+func ProvideJobRunStore(pool *pgxpool.Pool, m *metrics.Metrics) job.RunStore {
+	return postgres.NewJobRunRepository(pool, m)
+}
+
+func ProvideScheduler(ls listener2.Service, pub shared.BroadcastPublisher, reg *station.Registry, le *leader.Elector, locks *leader.Locks, store job.RunStore, ob outbox.Store, cfg config.Config, m *metrics.Metrics, log *logger.Logger) (scheduler.Scheduler, error) {
+	sched := scheduler.NewScheduler(le, locks, store, m, log)
+
+	jobs := []job.Job{job.NewTrackListeners(ls, pub, reg, m), job.NewPruneJobRuns(store, log), job.NewPruneOutbox(ob, log)}
+	for _, j := range jobs {
+		spec, enabled, overlap, timeout := cfg.Job(j.Name())
+		opts := job.Options{Spec: spec, Enabled: enabled, Overlap: overlap, Timeout: timeout}
+		if err := sched.Register(j, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, enabled, _, timeout := cfg.Job(job.TrackListeners); enabled && timeout > 0 {
+		if budget := streaming.RequestBudget(cfg.StreamingHTTP()); budget >= timeout {
+			return nil, fmt.Errorf("job %s: timeout %s must exceed %s, the longest a streaming server request can take", job.TrackListeners, timeout, budget)
+		}
+	}
+
+	return sched, nil
+}
+
+func ProvideApplication(cfg config.Config, log *logger.Logger, db database.Database, srv *server.Server, admin *server.AdminServer, sched scheduler.Scheduler, relay *outbox.Relay, ww *webhook.Worker, bus *events.StreamBus, lh *live.Hub) *Application {
+	return &Application{Config: cfg, Logger: log, Database: db, Server: srv, AdminServer: admin, Scheduler: sched, OutboxRelay: relay, Webhooks: ww, EventBus: bus, Live: lh}
+}
+
+func ProvideTracksApp(cfg config.Config, log *logger.Logger, db database.Database, reg *station.Registry, bh *track.BackfillNamesHandler) *TracksApp {
+	return &TracksApp{Config: cfg, Logger: log, Database: db, Stations: reg, BackfillNames: bh}
+}
+
+func ProvideAPIKeyApp(cfg config.Config, log *logger.Logger, db database.Database, reg *station.Registry, ch *apikey.CreateAPIKeyHandler, lh *apikey.ListAPIKeysHandler, rh *apikey.RevokeAPIKeyHandler) *APIKeyApp {
+	return &APIKeyApp{Config: cfg, Logger: log, Database: db, Stations: reg, Create: ch, List: lh, Revoke: rh}
 }
 
 func ProvideMigrateApp(cfg config.Config, log *logger.Logger, dsn string) *MigrateApp {
@@ -244,15 +785,39 @@ func ProvideMigrateApp(cfg config.Config, log *logger.Logger, dsn string) *Migra
 }
 
 var ProviderSet = wire.NewSet(
-	ProvideConfig, ProvideLogger, ProvideDSN, ProvideDatabase, ProvidePool, ProvideEventPublisher,
-	ProvideCache, ProvideRedisClient, ProvideMetrics, ProvideUnitOfWork,
-	ProvideTrackRepository, ProvideTrackDomainRepository, ProvideReactionRepository,
-	ProvideListenerRepository, ProvideStatisticsRepository,
-	ProvideListenerAdapter, ProvideTrackListenerAdapter,
-	ProvideUpsertTrackHandler, ProvideGetTrackHandler, ProvideAddReactionHandler, ProvideCheckReactionHandler,
-	ProvideIcecastClient, ProvideRadioService, ProvideStatisticsService, ProvideListenerService,
-	ProvideTrackHandler, ProvideReactionHandler, ProvideRadioHandler, ProvideStatisticsHandler, ProvideHealthHandler,
-	ProvideRouter, ProvideServer, ProvideScheduler, ProvideApplication,
+	ProvideConfig, ProvideLogger, ProvideDSN, ProvideDatabase, ProvidePool, ProvideEventPublisher, ProvideBroadcastHandlers, ProvideEventBus, ProvideEventRouting, ProvideBroadcastPublisher,
+	ProvideCache, ProvideRedisClient, ProvideMetrics, ProvideUnitOfWork, ProvideOutboxStore, ProvideOutboxRelay,
+	ProvideStationRepository, ProvideStationRegistry, ProvideStationMiddleware, ProvideStationHandler,
+	ProvideTrackRepository, ProvideTrackDomainRepository, ProvideReactionRepository, ProvideReactionDomainRepository, ProvideReactionTypeRepository,
+	ProvideListenerRepository, ProvideStatisticsRepository, ProvidePlayRepository, ProvidePlayDomainRepository,
+	ProvideArtistRepository, ProvideListenerAdapter, ProvideTrackListenerAdapter,
+	ProvideTitleParser, ProvideUpsertTrackHandler, ProvideGetTrackHandler, ProvideListTracksHandler, ProvideAddReactionHandler, ProvideCheckReactionHandler,
+	ProvideChangeReactionHandler, ProvideRemoveReactionHandler, ProvideListReactionTypesHandler,
+	ProvideRecordPlayHandler, ProvideGetHistoryHandler, ProvideNowPlayingBroadcaster, ProvideListArtistsHandler, ProvideGetArtistHandler,
+	ProvideStreamingClients, ProvideRadioService, ProvideStatisticsRegistry, ProvideStatisticsService, ProvideListenerService,
+	ProvideListenerSessionRepository, ProvideListenerSessionDomainRepository, ProvideSessionTracker,
+	ProvideIdentityService, ProvideIdentityMiddleware, ProvideIdentityRateLimit,
+	ProvideAPIKeyRepository, ProvideAuthenticateHandler, ProvideAPIKeyMiddleware,
+	ProvideTrackHandler, ProvideReactionHandler, ProvideRadioHandler, ProvideStatisticsHandler, ProvideHistoryHandler,
+	ProvideNowPlayingHandler, ProvideArtistHandler, ProvideIdentityHandler, ProvideHealthHandler,
+	ProvideLiveHub, ProvideLiveGateway,
+	ProvideWebhookRepository, ProvideWebhookDeliveryRepository, ProvideCreateWebhookHandler, ProvideListWebhooksHandler,
+	ProvideDeleteWebhookHandler, ProvideListDeliveriesHandler, ProvideEnqueueDeliveriesHandler, ProvideWebhookWorker, ProvideWebhookHandler,
+	ProvideRouter, ProvideServer, ProvideAdminServer, ProvideLeaderElector, ProvideJobLocks, ProvideJobRunStore, ProvideScheduler, ProvideJobHandler, ProvideApplication,
+)
+
+var TracksProviderSet = wire.NewSet(
+	ProvideConfig, ProvideLogger, ProvideDatabase, ProvidePool, ProvideMetrics,
+	ProvideStationRepository, ProvideStationRegistry,
+	ProvideTrackRepository, ProvideTrackDomainRepository,
+	ProvideTitleParser, ProvideBackfillNamesHandler, ProvideTracksApp,
+)
+
+var APIKeyProviderSet = wire.NewSet(
+	ProvideConfig, ProvideLogger, ProvideDatabase, ProvidePool, ProvideMetrics,
+	ProvideStationRepository, ProvideStationRegistry,
+	ProvideAPIKeyRepository, ProvideCreateAPIKeyHandler, ProvideListAPIKeysHandler, ProvideRevokeAPIKeyHandler,
+	ProvideAPIKeyApp,
 )
 
 var MigrateProviderSet = wire.NewSet(ProvideConfig, ProvideLogger, ProvideDSN, ProvideMigrateApp)
@@ -0,0 +1,2 @@
+-- Migration down: Drop plays table
+DROP TABLE IF EXISTS plays;
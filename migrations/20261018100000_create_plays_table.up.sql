@@ -0,0 +1,13 @@
+-- Migration up: Create plays table
+CREATE TABLE plays (
+    id UUID PRIMARY KEY,
+    track_id CHAR(32) NOT NULL REFERENCES tracks(id) ON DELETE CASCADE,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMP WITH TIME ZONE,
+    listeners_start INTEGER NOT NULL DEFAULT 0,
+    listeners_end INTEGER
+);
+
+CREATE INDEX idx_plays_started_at ON plays (started_at DESC, id DESC);
+CREATE INDEX idx_plays_track_id ON plays (track_id);
+CREATE INDEX idx_plays_open ON plays (started_at DESC) WHERE ended_at IS NULL;
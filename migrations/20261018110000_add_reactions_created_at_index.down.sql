@@ -0,0 +1,2 @@
+-- Migration down: Drop reactions time index
+DROP INDEX IF EXISTS idx_reactions_created_at;
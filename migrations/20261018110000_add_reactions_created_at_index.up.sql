@@ -0,0 +1,2 @@
+-- Migration up: Index reactions by time for windowed statistics
+CREATE INDEX idx_reactions_created_at ON reactions (created_at DESC);
@@ -0,0 +1,7 @@
+-- Migration down: Drop track catalogue indexes
+DROP INDEX IF EXISTS idx_tracks_updated_at;
+DROP INDEX IF EXISTS idx_tracks_listeners;
+DROP INDEX IF EXISTS idx_tracks_dislikes;
+DROP INDEX IF EXISTS idx_tracks_likes;
+DROP INDEX IF EXISTS idx_tracks_rotate;
+DROP INDEX IF EXISTS idx_tracks_title_trgm;
@@ -0,0 +1,9 @@
+-- Migration up: Trigram index for case-insensitive track title search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_tracks_title_trgm ON tracks USING gin (title gin_trgm_ops);
+CREATE INDEX idx_tracks_rotate ON tracks (rotate DESC, id DESC);
+CREATE INDEX idx_tracks_likes ON tracks (likes DESC, id DESC);
+CREATE INDEX idx_tracks_dislikes ON tracks (dislikes DESC, id DESC);
+CREATE INDEX idx_tracks_listeners ON tracks (listeners DESC, id DESC);
+CREATE INDEX idx_tracks_updated_at ON tracks (updated_at DESC, id DESC);
@@ -0,0 +1,7 @@
+-- Migration down: Drop parsed artist and song columns from tracks
+DROP INDEX IF EXISTS idx_tracks_artist;
+
+ALTER TABLE tracks
+    DROP COLUMN IF EXISTS featuring,
+    DROP COLUMN IF EXISTS song,
+    DROP COLUMN IF EXISTS artist;
@@ -0,0 +1,7 @@
+-- Migration up: Add parsed artist and song columns to tracks
+ALTER TABLE tracks
+    ADD COLUMN artist TEXT NOT NULL DEFAULT '',
+    ADD COLUMN song TEXT NOT NULL DEFAULT '',
+    ADD COLUMN featuring TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_tracks_artist ON tracks (lower(artist));
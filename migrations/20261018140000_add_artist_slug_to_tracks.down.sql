@@ -0,0 +1,4 @@
+-- Migration down: Drop artist slug from tracks
+DROP INDEX IF EXISTS idx_tracks_artist_slug;
+
+ALTER TABLE tracks DROP COLUMN IF EXISTS artist_slug;
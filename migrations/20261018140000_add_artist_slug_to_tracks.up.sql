@@ -0,0 +1,10 @@
+-- Migration up: Add artist slug to tracks for artist aggregation
+ALTER TABLE tracks ADD COLUMN artist_slug TEXT NOT NULL DEFAULT '';
+
+-- Matches artist.NewSlug for ASCII names only; the others are filled in by
+-- "tracks backfill-names", which derives slugs in Go
+UPDATE tracks
+SET artist_slug = trim(both '-' from regexp_replace(lower(artist), '[^a-z0-9]+', '-', 'g'))
+WHERE artist != '' AND artist !~ '[^\x01-\x7f]';
+
+CREATE INDEX idx_tracks_artist_slug ON tracks (artist_slug) WHERE artist_slug != '';
@@ -0,0 +1,10 @@
+-- Migration down: Restore fixed like/dislike reaction types
+DROP TABLE IF EXISTS track_reaction_counts;
+
+DELETE FROM reactions WHERE reaction NOT IN ('like', 'dislike');
+ALTER TABLE reactions DROP CONSTRAINT IF EXISTS reactions_reaction_fkey;
+ALTER TABLE reactions ALTER COLUMN reaction TYPE VARCHAR(10);
+ALTER TABLE reactions
+    ADD CONSTRAINT reactions_reaction_check CHECK (reaction IN ('like', 'dislike'));
+
+DROP TABLE IF EXISTS reaction_types;
@@ -0,0 +1,31 @@
+-- Migration up: Make reaction types data-driven with per-type track counters
+CREATE TABLE reaction_types (
+    key VARCHAR(32) PRIMARY KEY,
+    emoji TEXT NOT NULL DEFAULT '',
+    label TEXT NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO reaction_types (key, emoji, label, position) VALUES
+    ('like', '👍', 'Like', 10),
+    ('dislike', '👎', 'Dislike', 20),
+    ('fire', '🔥', 'Fire', 30),
+    ('love', '❤️', 'Love', 40),
+    ('sleepy', '😴', 'Sleepy', 50);
+
+ALTER TABLE reactions DROP CONSTRAINT IF EXISTS reactions_reaction_check;
+ALTER TABLE reactions ALTER COLUMN reaction TYPE VARCHAR(32);
+ALTER TABLE reactions
+    ADD CONSTRAINT reactions_reaction_fkey FOREIGN KEY (reaction) REFERENCES reaction_types(key);
+
+CREATE TABLE track_reaction_counts (
+    track_id CHAR(32) NOT NULL REFERENCES tracks(id) ON DELETE CASCADE,
+    reaction VARCHAR(32) NOT NULL REFERENCES reaction_types(key) ON DELETE CASCADE,
+    count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (track_id, reaction)
+);
+
+INSERT INTO track_reaction_counts (track_id, reaction, count)
+SELECT track_id, reaction, COUNT(*) FROM reactions GROUP BY track_id, reaction;
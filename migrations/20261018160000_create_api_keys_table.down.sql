@@ -0,0 +1,2 @@
+-- Migration down: Drop api_keys table
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,16 @@
+-- Migration up: Create outbox_events table
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_name VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_dead ON outbox_events (id) WHERE status = 'dead';
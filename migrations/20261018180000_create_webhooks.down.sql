@@ -0,0 +1,3 @@
+-- Migration down: Drop webhooks and webhook_deliveries tables
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,2 @@
+-- Migration down: Drop correlation_id from outbox_events
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS correlation_id;
@@ -0,0 +1,2 @@
+-- Migration up: Add correlation_id to outbox_events
+ALTER TABLE outbox_events ADD COLUMN correlation_id VARCHAR(64);
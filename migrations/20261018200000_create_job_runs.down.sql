@@ -0,0 +1,2 @@
+-- Migration down: Drop job_runs table
+DROP TABLE IF EXISTS job_runs;
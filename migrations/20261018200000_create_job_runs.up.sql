@@ -0,0 +1,12 @@
+-- Migration up: Create job_runs table
+CREATE TABLE job_runs (
+    id BIGSERIAL PRIMARY KEY,
+    job_name VARCHAR(64) NOT NULL,
+    trigger VARCHAR(16) NOT NULL CHECK (trigger IN ('schedule', 'manual')),
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    error TEXT
+);
+
+CREATE INDEX idx_job_runs_job_name ON job_runs (job_name, id DESC);
+CREATE INDEX idx_job_runs_started_at ON job_runs (started_at);
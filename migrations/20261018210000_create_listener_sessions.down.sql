@@ -0,0 +1,3 @@
+-- Migration down: Drop listener_sessions and listener_session_tracks tables
+DROP TABLE IF EXISTS listener_session_tracks;
+DROP TABLE IF EXISTS listener_sessions;
@@ -0,0 +1,26 @@
+-- Migration up: Create listener_sessions and listener_session_tracks tables
+CREATE TABLE listener_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id CHAR(32) NOT NULL,
+    client_id BIGINT NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE,
+    duration_seconds INTEGER,
+    first_track_id CHAR(32) REFERENCES tracks(id) ON DELETE SET NULL,
+    last_track_id CHAR(32) REFERENCES tracks(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_listener_sessions_open ON listener_sessions (user_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_listener_sessions_started_at ON listener_sessions (started_at DESC);
+CREATE INDEX idx_listener_sessions_first_track_id ON listener_sessions (first_track_id);
+CREATE INDEX idx_listener_sessions_last_track_id ON listener_sessions (last_track_id) WHERE ended_at IS NOT NULL;
+
+CREATE TABLE listener_session_tracks (
+    session_id BIGINT NOT NULL REFERENCES listener_sessions(id) ON DELETE CASCADE,
+    track_id CHAR(32) NOT NULL REFERENCES tracks(id) ON DELETE CASCADE,
+    heard_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (session_id, track_id)
+);
+
+CREATE INDEX idx_listener_session_tracks_track_id ON listener_session_tracks (track_id);
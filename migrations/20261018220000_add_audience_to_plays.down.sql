@@ -0,0 +1,6 @@
+-- Migration down: Remove listener movement counts from plays
+DROP INDEX IF EXISTS idx_listener_sessions_ended_at;
+
+ALTER TABLE plays
+    DROP COLUMN IF EXISTS listeners_tuned_out,
+    DROP COLUMN IF EXISTS listeners_joined;
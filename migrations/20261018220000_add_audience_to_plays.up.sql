@@ -0,0 +1,6 @@
+-- Migration up: Add listener movement counts to plays
+ALTER TABLE plays
+    ADD COLUMN listeners_joined INTEGER,
+    ADD COLUMN listeners_tuned_out INTEGER;
+
+CREATE INDEX idx_listener_sessions_ended_at ON listener_sessions (ended_at) WHERE ended_at IS NOT NULL;
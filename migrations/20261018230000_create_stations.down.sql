@@ -0,0 +1,55 @@
+-- Migration down: Drop stations and the station scope of tracks and their data
+-- Only the default station's data fits the unscoped tables; keys restricted
+-- to another station must not become valid for the default one
+DELETE FROM api_keys WHERE station_id <> 1;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS station_id;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS station_id;
+
+DELETE FROM tracks WHERE station_id <> 1;
+DELETE FROM listener_sessions WHERE station_id <> 1;
+
+ALTER TABLE listener_session_tracks DROP CONSTRAINT listener_session_tracks_track_fkey;
+ALTER TABLE listener_session_tracks DROP COLUMN station_id;
+
+DROP INDEX IF EXISTS idx_listener_sessions_open;
+ALTER TABLE listener_sessions
+    DROP CONSTRAINT listener_sessions_first_track_fkey,
+    DROP CONSTRAINT listener_sessions_last_track_fkey;
+ALTER TABLE listener_sessions DROP COLUMN station_id;
+CREATE INDEX idx_listener_sessions_open ON listener_sessions (user_id) WHERE ended_at IS NULL;
+
+ALTER TABLE track_reaction_counts DROP CONSTRAINT track_reaction_counts_track_fkey;
+ALTER TABLE track_reaction_counts DROP CONSTRAINT track_reaction_counts_pkey;
+ALTER TABLE track_reaction_counts DROP COLUMN station_id;
+ALTER TABLE track_reaction_counts ADD PRIMARY KEY (track_id, reaction);
+
+DROP INDEX IF EXISTS idx_plays_station_started_at;
+DROP INDEX IF EXISTS idx_plays_open;
+ALTER TABLE plays DROP CONSTRAINT plays_track_fkey;
+ALTER TABLE plays DROP COLUMN station_id;
+CREATE INDEX idx_plays_open ON plays (started_at DESC) WHERE ended_at IS NULL;
+
+ALTER TABLE listeners DROP CONSTRAINT listeners_track_fkey;
+ALTER TABLE listeners DROP CONSTRAINT listeners_station_id_user_id_track_id_key;
+ALTER TABLE listeners DROP COLUMN station_id;
+ALTER TABLE listeners ADD CONSTRAINT listeners_user_id_track_id_key UNIQUE (user_id, track_id);
+
+ALTER TABLE reactions DROP CONSTRAINT reactions_track_fkey;
+ALTER TABLE reactions DROP CONSTRAINT reactions_station_id_user_id_track_id_key;
+ALTER TABLE reactions DROP COLUMN station_id;
+ALTER TABLE reactions ADD CONSTRAINT reactions_user_id_track_id_key UNIQUE (user_id, track_id);
+
+ALTER TABLE tracks DROP CONSTRAINT tracks_pkey;
+ALTER TABLE tracks DROP COLUMN station_id;
+ALTER TABLE tracks ADD PRIMARY KEY (id);
+
+ALTER TABLE reactions ADD CONSTRAINT reactions_track_id_fkey FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE;
+ALTER TABLE listeners ADD CONSTRAINT listeners_track_id_fkey FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE;
+ALTER TABLE plays ADD CONSTRAINT plays_track_id_fkey FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE;
+ALTER TABLE track_reaction_counts ADD CONSTRAINT track_reaction_counts_track_id_fkey FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE;
+ALTER TABLE listener_sessions
+    ADD CONSTRAINT listener_sessions_first_track_id_fkey FOREIGN KEY (first_track_id) REFERENCES tracks(id) ON DELETE SET NULL,
+    ADD CONSTRAINT listener_sessions_last_track_id_fkey FOREIGN KEY (last_track_id) REFERENCES tracks(id) ON DELETE SET NULL;
+ALTER TABLE listener_session_tracks ADD CONSTRAINT listener_session_tracks_track_id_fkey FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE;
+
+DROP TABLE IF EXISTS stations;
@@ -0,0 +1,86 @@
+-- Migration up: Create stations and scope tracks and their data by station
+
+-- Session track references are cleared with ON DELETE SET NULL (column),
+-- which needs PostgreSQL 15
+DO $$
+BEGIN
+    IF current_setting('server_version_num')::int < 150000 THEN
+        RAISE EXCEPTION 'PostgreSQL 15 or later is required, found %', current_setting('server_version');
+    END IF;
+END
+$$;
+CREATE TABLE stations (
+    id BIGSERIAL PRIMARY KEY,
+    slug VARCHAR(64) NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Existing data belongs to the default station
+INSERT INTO stations (id, slug, name) VALUES (1, 'main', 'Main');
+SELECT setval('stations_id_seq', 1);
+
+-- Track IDs are only unique within a station, so references to tracks
+-- become (station_id, track_id)
+ALTER TABLE reactions DROP CONSTRAINT reactions_track_id_fkey;
+ALTER TABLE listeners DROP CONSTRAINT listeners_track_id_fkey;
+ALTER TABLE plays DROP CONSTRAINT plays_track_id_fkey;
+ALTER TABLE track_reaction_counts DROP CONSTRAINT track_reaction_counts_track_id_fkey;
+ALTER TABLE listener_sessions
+    DROP CONSTRAINT listener_sessions_first_track_id_fkey,
+    DROP CONSTRAINT listener_sessions_last_track_id_fkey;
+ALTER TABLE listener_session_tracks DROP CONSTRAINT listener_session_tracks_track_id_fkey;
+
+ALTER TABLE tracks ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1 REFERENCES stations(id) ON DELETE CASCADE;
+ALTER TABLE tracks DROP CONSTRAINT tracks_pkey, ADD PRIMARY KEY (station_id, id);
+ALTER TABLE tracks ALTER COLUMN station_id DROP DEFAULT;
+
+ALTER TABLE reactions ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE reactions
+    DROP CONSTRAINT reactions_user_id_track_id_key,
+    ADD CONSTRAINT reactions_station_id_user_id_track_id_key UNIQUE (station_id, user_id, track_id),
+    ADD CONSTRAINT reactions_track_fkey FOREIGN KEY (station_id, track_id) REFERENCES tracks(station_id, id) ON DELETE CASCADE;
+ALTER TABLE reactions ALTER COLUMN station_id DROP DEFAULT;
+
+ALTER TABLE listeners ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE listeners
+    DROP CONSTRAINT listeners_user_id_track_id_key,
+    ADD CONSTRAINT listeners_station_id_user_id_track_id_key UNIQUE (station_id, user_id, track_id),
+    ADD CONSTRAINT listeners_track_fkey FOREIGN KEY (station_id, track_id) REFERENCES tracks(station_id, id) ON DELETE CASCADE;
+ALTER TABLE listeners ALTER COLUMN station_id DROP DEFAULT;
+
+ALTER TABLE plays ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE plays
+    ADD CONSTRAINT plays_track_fkey FOREIGN KEY (station_id, track_id) REFERENCES tracks(station_id, id) ON DELETE CASCADE;
+ALTER TABLE plays ALTER COLUMN station_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_plays_open;
+CREATE INDEX idx_plays_open ON plays (station_id, started_at DESC) WHERE ended_at IS NULL;
+CREATE INDEX idx_plays_station_started_at ON plays (station_id, started_at DESC, id DESC);
+
+ALTER TABLE track_reaction_counts ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE track_reaction_counts
+    DROP CONSTRAINT track_reaction_counts_pkey,
+    ADD PRIMARY KEY (station_id, track_id, reaction),
+    ADD CONSTRAINT track_reaction_counts_track_fkey FOREIGN KEY (station_id, track_id) REFERENCES tracks(station_id, id) ON DELETE CASCADE;
+ALTER TABLE track_reaction_counts ALTER COLUMN station_id DROP DEFAULT;
+
+ALTER TABLE listener_sessions ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1 REFERENCES stations(id) ON DELETE CASCADE;
+ALTER TABLE listener_sessions
+    ADD CONSTRAINT listener_sessions_first_track_fkey FOREIGN KEY (station_id, first_track_id)
+        REFERENCES tracks(station_id, id) ON DELETE SET NULL (first_track_id),
+    ADD CONSTRAINT listener_sessions_last_track_fkey FOREIGN KEY (station_id, last_track_id)
+        REFERENCES tracks(station_id, id) ON DELETE SET NULL (last_track_id);
+ALTER TABLE listener_sessions ALTER COLUMN station_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_listener_sessions_open;
+CREATE INDEX idx_listener_sessions_open ON listener_sessions (station_id, user_id) WHERE ended_at IS NULL;
+
+ALTER TABLE listener_session_tracks ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE listener_session_tracks
+    ADD CONSTRAINT listener_session_tracks_track_fkey FOREIGN KEY (station_id, track_id) REFERENCES tracks(station_id, id) ON DELETE CASCADE;
+ALTER TABLE listener_session_tracks ALTER COLUMN station_id DROP DEFAULT;
+
+-- Events carry their station to the handlers
+ALTER TABLE outbox_events ADD COLUMN station_id BIGINT NOT NULL DEFAULT 1;
+
+-- Playout keys may be restricted to one station; NULL grants every station
+ALTER TABLE api_keys ADD COLUMN station_id BIGINT REFERENCES stations(id) ON DELETE CASCADE;
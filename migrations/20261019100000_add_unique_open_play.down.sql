@@ -0,0 +1,3 @@
+-- Migration down: Allow several open plays per station again
+DROP INDEX IF EXISTS idx_plays_open;
+CREATE INDEX idx_plays_open ON plays (station_id, started_at DESC) WHERE ended_at IS NULL;
@@ -0,0 +1,10 @@
+-- Migration up: Allow a single open play per station
+UPDATE plays p SET ended_at = p.started_at
+WHERE p.ended_at IS NULL AND EXISTS (
+    SELECT 1 FROM plays n
+    WHERE n.station_id = p.station_id AND n.ended_at IS NULL
+      AND (n.started_at, n.id) > (p.started_at, p.id)
+);
+
+DROP INDEX IF EXISTS idx_plays_open;
+CREATE UNIQUE INDEX idx_plays_open ON plays (station_id) WHERE ended_at IS NULL;
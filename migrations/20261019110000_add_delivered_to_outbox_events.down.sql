@@ -0,0 +1,4 @@
+-- Migration down: Drop per-subscriber outbox delivery tracking
+DROP INDEX IF EXISTS idx_outbox_events_delivered_at;
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS delivered_to;
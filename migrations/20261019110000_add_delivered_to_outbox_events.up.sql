@@ -0,0 +1,4 @@
+-- Migration up: Track outbox delivery per subscriber and index delivered events for pruning
+ALTER TABLE outbox_events ADD COLUMN delivered_to TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_outbox_events_delivered_at ON outbox_events (delivered_at) WHERE status = 'delivered';
@@ -0,0 +1,3 @@
+-- Migration down: Drop the event key of webhook deliveries
+ALTER TABLE webhook_deliveries DROP CONSTRAINT IF EXISTS uq_webhook_deliveries_event;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS event_id;
@@ -0,0 +1,5 @@
+-- Migration up: Key webhook deliveries by event so a redelivered event is enqueued once
+ALTER TABLE webhook_deliveries ADD COLUMN event_id TEXT;
+
+ALTER TABLE webhook_deliveries
+    ADD CONSTRAINT uq_webhook_deliveries_event UNIQUE (webhook_id, event_id);
@@ -0,0 +1,3 @@
+-- Migration down: Allow several open sessions per listener and station again
+DROP INDEX IF EXISTS idx_listener_sessions_open;
+CREATE INDEX idx_listener_sessions_open ON listener_sessions (station_id, user_id) WHERE ended_at IS NULL;
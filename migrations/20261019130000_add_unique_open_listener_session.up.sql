@@ -0,0 +1,12 @@
+-- Migration up: Allow a single open session per listener and station
+UPDATE listener_sessions s
+SET ended_at = s.last_seen_at,
+    duration_seconds = GREATEST(EXTRACT(EPOCH FROM s.last_seen_at - s.started_at), 0)::INTEGER
+WHERE s.ended_at IS NULL AND EXISTS (
+    SELECT 1 FROM listener_sessions n
+    WHERE n.station_id = s.station_id AND n.user_id = s.user_id AND n.ended_at IS NULL
+      AND (n.started_at, n.id) > (s.started_at, s.id)
+);
+
+DROP INDEX IF EXISTS idx_listener_sessions_open;
+CREATE UNIQUE INDEX idx_listener_sessions_open ON listener_sessions (station_id, user_id) WHERE ended_at IS NULL;
@@ -0,0 +1,2 @@
+-- Migration down: Drop the listener sessions open at the start of plays
+ALTER TABLE plays DROP COLUMN IF EXISTS listeners_present;
@@ -0,0 +1,2 @@
+-- Migration up: Record the listener sessions open at the start of plays, the tune-out rate denominator
+ALTER TABLE plays ADD COLUMN listeners_present INTEGER;
@@ -0,0 +1,3 @@
+-- Migration down: Drop the station of webhooks and their deliveries
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS station;
+ALTER TABLE webhooks DROP COLUMN IF EXISTS station_id;
@@ -0,0 +1,6 @@
+-- Migration up: Scope webhooks to a station and tag deliveries with theirs
+-- A webhook may subscribe to one station; NULL subscribes to every station
+ALTER TABLE webhooks ADD COLUMN station_id BIGINT REFERENCES stations(id) ON DELETE CASCADE;
+
+-- Slug of the station the event happened on, sent as X-Hub-Station
+ALTER TABLE webhook_deliveries ADD COLUMN station VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,16 @@
+-- Migration down: Restore the track indexes without the station
+DROP INDEX IF EXISTS idx_tracks_created_at;
+DROP INDEX IF EXISTS idx_tracks_rotate;
+DROP INDEX IF EXISTS idx_tracks_likes;
+DROP INDEX IF EXISTS idx_tracks_dislikes;
+DROP INDEX IF EXISTS idx_tracks_listeners;
+DROP INDEX IF EXISTS idx_tracks_updated_at;
+DROP INDEX IF EXISTS idx_tracks_artist_slug;
+
+CREATE INDEX idx_tracks_created_at ON tracks (created_at DESC);
+CREATE INDEX idx_tracks_rotate ON tracks (rotate DESC, id DESC);
+CREATE INDEX idx_tracks_likes ON tracks (likes DESC, id DESC);
+CREATE INDEX idx_tracks_dislikes ON tracks (dislikes DESC, id DESC);
+CREATE INDEX idx_tracks_listeners ON tracks (listeners DESC, id DESC);
+CREATE INDEX idx_tracks_updated_at ON tracks (updated_at DESC, id DESC);
+CREATE INDEX idx_tracks_artist_slug ON tracks (artist_slug) WHERE artist_slug != '';
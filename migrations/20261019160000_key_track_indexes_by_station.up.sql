@@ -0,0 +1,17 @@
+-- Migration up: Lead the track keyset and artist indexes with the station
+-- Every catalogue, statistics and artist query filters by station first
+DROP INDEX IF EXISTS idx_tracks_created_at;
+DROP INDEX IF EXISTS idx_tracks_rotate;
+DROP INDEX IF EXISTS idx_tracks_likes;
+DROP INDEX IF EXISTS idx_tracks_dislikes;
+DROP INDEX IF EXISTS idx_tracks_listeners;
+DROP INDEX IF EXISTS idx_tracks_updated_at;
+DROP INDEX IF EXISTS idx_tracks_artist_slug;
+
+CREATE INDEX idx_tracks_created_at ON tracks (station_id, created_at DESC, id DESC);
+CREATE INDEX idx_tracks_rotate ON tracks (station_id, rotate DESC, id DESC);
+CREATE INDEX idx_tracks_likes ON tracks (station_id, likes DESC, id DESC);
+CREATE INDEX idx_tracks_dislikes ON tracks (station_id, dislikes DESC, id DESC);
+CREATE INDEX idx_tracks_listeners ON tracks (station_id, listeners DESC, id DESC);
+CREATE INDEX idx_tracks_updated_at ON tracks (station_id, updated_at DESC, id DESC);
+CREATE INDEX idx_tracks_artist_slug ON tracks (station_id, artist_slug) WHERE artist_slug != '';